@@ -0,0 +1,3 @@
+data "omegaup_group" "group" {
+  alias = "alias"
+}
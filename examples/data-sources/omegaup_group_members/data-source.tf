@@ -0,0 +1,8 @@
+data "omegaup_group_members" "roster" {
+  group_alias     = "alias"
+  username_prefix = "alias:"
+}
+
+output "roster" {
+  value = { for member in data.omegaup_group_members.roster.members : member.username => member.school }
+}
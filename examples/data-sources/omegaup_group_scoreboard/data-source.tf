@@ -0,0 +1,8 @@
+data "omegaup_group_scoreboard" "league" {
+  group_alias = "alias"
+  alias       = "league"
+}
+
+output "podium" {
+  value = [for rank in slice(data.omegaup_group_scoreboard.league.ranking, 0, 3) : rank.username]
+}
@@ -0,0 +1,7 @@
+data "omegaup_groups" "training" {
+  alias_regex = "^training-"
+}
+
+output "training_groups" {
+  value = [for group in data.omegaup_groups.training.groups : group.alias]
+}
@@ -0,0 +1 @@
+terraform import omegaup_group_membership.members alias
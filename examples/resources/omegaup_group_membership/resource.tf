@@ -0,0 +1,5 @@
+resource "omegaup_group_membership" "members" {
+  group_alias     = "alias"
+  usernames       = ["user1", "user2"]
+  ignore_patterns = ["^admin_"]
+}
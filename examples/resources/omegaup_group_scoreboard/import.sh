@@ -0,0 +1 @@
+terraform import omegaup_group_scoreboard.league alias,league
@@ -0,0 +1,15 @@
+resource "omegaup_group_scoreboard" "league" {
+  group_alias = "alias"
+  alias       = "league"
+  description = "Monthly training league"
+
+  contests {
+    alias = "round-1"
+  }
+
+  contests {
+    alias   = "round-2"
+    weight  = 2
+    only_ac = true
+  }
+}
@@ -0,0 +1,15 @@
+resource "omegaup_team_identities" "teams" {
+  teams_group_alias = "teams"
+  identities = [
+    {
+      username    = "teams:teams:team1"
+      name        = "Team 1"
+      gender      = "other"
+      password    = "password1"
+      school_name = "OFMI"
+      country_id  = "MX"
+      state_id    = "MEX"
+      usernames   = ["user1", "user2", "user3"]
+    },
+  ]
+}
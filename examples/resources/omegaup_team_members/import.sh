@@ -0,0 +1 @@
+terraform import omegaup_team_members.team1 alias,teams:alias:team1
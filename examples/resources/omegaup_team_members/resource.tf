@@ -0,0 +1,5 @@
+resource "omegaup_team_members" "team1" {
+  teams_group_alias = "alias"
+  team_username     = "teams:alias:team1"
+  usernames         = ["user1", "user2", "user3"]
+}
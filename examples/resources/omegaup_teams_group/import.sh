@@ -0,0 +1 @@
+terraform import omegaup_teams_group.teams alias
@@ -0,0 +1,5 @@
+resource "omegaup_teams_group" "teams" {
+  alias                 = "alias"
+  description           = "a description"
+  number_of_contestants = 3
+}
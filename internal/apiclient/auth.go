@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	loginEndpoint = "/api/user/login"
+	// Name of the cookie omegaUp uses to carry the session auth token.
+	authTokenCookie = "ouat"
+)
+
+func (c *Client) UserLogin(ctx context.Context, req *UserLoginRequest) (*UserLoginResponse, error) {
+	var res *UserLoginResponse
+	bytes, err := c.query(ctx, loginEndpoint, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type UserLoginRequest struct {
+	UsernameOrEmail string `json:"usernameOrEmail"`
+	Password        string `json:"password"`
+}
+
+type UserLoginResponse struct {
+	AuthToken string `json:"auth_token"`
+}
+
+// usesSession reports whether the client authenticates with a username and
+// password instead of an API token.
+func (c *Client) usesSession() bool {
+	return c.ApiToken == "" && c.Username != ""
+}
+
+// session returns the current auth token, logging in if there is none yet.
+func (c *Client) session(ctx context.Context) (string, error) {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	if c.authToken != "" {
+		return c.authToken, nil
+	}
+
+	res, err := c.UserLogin(ctx, &UserLoginRequest{
+		UsernameOrEmail: c.Username,
+		Password:        c.Password,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to log in as %s: %w", c.Username, err)
+	}
+	if res.AuthToken == "" {
+		return "", fmt.Errorf("unable to log in as %s: empty auth token", c.Username)
+	}
+	c.authToken = res.AuthToken
+	return c.authToken, nil
+}
+
+// expireSession forgets the given auth token so the next request logs in
+// again. Tokens other than the given one are kept, since another request may
+// have already renewed the session.
+func (c *Client) expireSession(token string) {
+	c.sessionMu.Lock()
+	defer c.sessionMu.Unlock()
+
+	if c.authToken == token {
+		c.authToken = ""
+	}
+}
+
+// authenticate adds the credentials to the request. It returns the session
+// token used, if any.
+func (c *Client) authenticate(ctx context.Context, endpoint string, req *http.Request) (string, error) {
+	if !c.usesSession() {
+		req.Header.Set("Authorization", fmt.Sprintf("token %s", c.ApiToken))
+		return "", nil
+	}
+	if endpoint == loginEndpoint {
+		return "", nil
+	}
+	token, err := c.session(ctx)
+	if err != nil {
+		return "", err
+	}
+	req.AddCookie(&http.Cookie{Name: authTokenCookie, Value: token})
+	return token, nil
+}
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQueryLogsInAgainWhenSessionExpires(t *testing.T) {
+	logins := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == loginEndpoint {
+			logins++
+			_, _ = fmt.Fprintf(w, `{"status":"ok","auth_token":"token%d"}`, logins)
+			return
+		}
+		cookie, err := r.Cookie(authTokenCookie)
+		// Only the second session is considered valid.
+		if err != nil || cookie.Value != "token2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"status":"error","errorname":"loginRequired"}`))
+			return
+		}
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("unexpected Authorization header")
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("", server.URL)
+	client.Username = "user"
+	client.Password = "password"
+
+	if _, err := client.query(context.Background(), "/api/group/create", map[string]string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logins != 2 {
+		t.Errorf("expected 2 logins, got %d", logins)
+	}
+	if _, err := client.query(context.Background(), "/api/group/create", map[string]string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if logins != 2 {
+		t.Errorf("expected the session to be reused, got %d logins", logins)
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// groupCache coalesces concurrent identical reads of a group and keeps their
+// responses for the lifetime of the client, which is a single Terraform run.
+// Every write to a group invalidates its entries.
+type groupCache struct {
+	mu          sync.Mutex
+	calls       map[string]*groupCall
+	entries     map[string][]byte
+	generations map[string]uint64
+}
+
+// groupCall is a fetch shared by every caller asking for the same response.
+// It is cancelled once all of them are gone.
+type groupCall struct {
+	done    chan struct{}
+	cancel  context.CancelFunc
+	waiters int
+	result  []byte
+	err     error
+}
+
+func newGroupCache() *groupCache {
+	return &groupCache{
+		calls:       make(map[string]*groupCall),
+		entries:     make(map[string][]byte),
+		generations: make(map[string]uint64),
+	}
+}
+
+func groupCacheKey(groupAlias string, endpoint string) string {
+	return groupAlias + "\x00" + endpoint
+}
+
+// get returns the cached response, or calls fetch once for all the callers
+// asking for the same group and endpoint at the same time.
+func (g *groupCache) get(ctx context.Context, groupAlias string, endpoint string, fetch func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	key := groupCacheKey(groupAlias, endpoint)
+
+	g.mu.Lock()
+	if entry, ok := g.entries[key]; ok {
+		g.mu.Unlock()
+		return entry, nil
+	}
+	generation := g.generations[groupAlias]
+
+	// Callers that arrive after an invalidation must not join a call that
+	// started before it.
+	callKey := fmt.Sprintf("%s\x00%d", key, generation)
+	call, ok := g.calls[callKey]
+	if !ok {
+		// The call is shared, so it must not be cancelled by the first
+		// caller, only once every caller is gone.
+		fetchCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		call = &groupCall{
+			done:   make(chan struct{}),
+			cancel: cancel,
+		}
+		g.calls[callKey] = call
+		go g.fetch(fetchCtx, call, callKey, groupAlias, key, generation, fetch)
+	}
+	call.waiters++
+	g.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		g.mu.Lock()
+		call.waiters--
+		if call.waiters == 0 {
+			call.cancel()
+			// Later callers must start a new call instead of joining the
+			// cancelled one.
+			if g.calls[callKey] == call {
+				delete(g.calls, callKey)
+			}
+		}
+		g.mu.Unlock()
+		return nil, ctx.Err()
+	case <-call.done:
+		g.mu.Lock()
+		call.waiters--
+		g.mu.Unlock()
+		return call.result, call.err
+	}
+}
+
+// fetch runs a shared call and stores its response, unless the group was
+// invalidated in the meantime.
+func (g *groupCache) fetch(ctx context.Context, call *groupCall, callKey string, groupAlias string, key string, generation uint64, fetch func(ctx context.Context) ([]byte, error)) {
+	defer call.cancel()
+	result, err := fetch(ctx)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.calls[callKey] == call {
+		delete(g.calls, callKey)
+	}
+	if err == nil && g.generations[groupAlias] == generation {
+		g.entries[key] = result
+	}
+	call.result, call.err = result, err
+	close(call.done)
+}
+
+// invalidate drops every cached response of a group.
+func (g *groupCache) invalidate(groupAlias string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.generations[groupAlias]++
+	for key := range g.entries {
+		if strings.HasPrefix(key, groupAlias+"\x00") {
+			delete(g.entries, key)
+		}
+	}
+}
+
+// queryGroupRead sends a read of a group through the cache.
+func (c *Client) queryGroupRead(ctx context.Context, groupAlias string, endpoint string, payload interface{}) ([]byte, error) {
+	return c.cache.get(ctx, groupAlias, endpoint, func(ctx context.Context) ([]byte, error) {
+		return c.query(ctx, endpoint, payload)
+	})
+}
+
+// queryGroupWrite sends a write to a group and invalidates its cached reads,
+// even if the write failed, since it may have been partially applied.
+func (c *Client) queryGroupWrite(ctx context.Context, groupAlias string, endpoint string, payload interface{}) ([]byte, error) {
+	defer c.cache.invalidate(groupAlias)
+	return c.query(ctx, endpoint, payload)
+}
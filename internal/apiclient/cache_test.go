@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroupMembersIsCoalescedAndInvalidated(t *testing.T) {
+	var membersCalls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/group/members" {
+			membersCalls.Add(1)
+			time.Sleep(20 * time.Millisecond)
+			_, _ = w.Write([]byte(`{"identities":[{"username":"user"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("token", server.URL)
+	client.SetRateLimit(RateLimitConfig{})
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			members, err := client.GroupMembers(ctx, &GroupMembersRequest{GroupAlias: "group"})
+			if err != nil || len(members.Identities) != 1 {
+				t.Errorf("unexpected result: %v, %v", members, err)
+			}
+		}()
+	}
+	wg.Wait()
+	if membersCalls.Load() != 1 {
+		t.Fatalf("expected a single members call, got %d", membersCalls.Load())
+	}
+
+	// Another group is not affected by the cache.
+	if _, err := client.GroupMembers(ctx, &GroupMembersRequest{GroupAlias: "other"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if membersCalls.Load() != 2 {
+		t.Fatalf("expected 2 members calls, got %d", membersCalls.Load())
+	}
+
+	// Writes invalidate the cached members.
+	if err := client.GroupAddUser(ctx, &GroupAddUserRequest{GroupAlias: "group", UsernameOrEmail: "new"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GroupMembers(ctx, &GroupMembersRequest{GroupAlias: "group"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if membersCalls.Load() != 3 {
+		t.Fatalf("expected 3 members calls, got %d", membersCalls.Load())
+	}
+}
+
+func TestGroupMembersIsCancelledWithTheLastCaller(t *testing.T) {
+	release := make(chan struct{})
+	cancelled := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server only notices the client going away once the body is read.
+		_, _ = io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+			cancelled <- struct{}{}
+		case <-release:
+			_, _ = w.Write([]byte(`{"identities":[]}`))
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient("token", server.URL)
+	client.SetRateLimit(RateLimitConfig{})
+
+	// The shared call survives as long as one of the callers waits for it.
+	first, cancelFirst := context.WithCancel(context.Background())
+	second, cancelSecond := context.WithCancel(context.Background())
+	results := make(chan error, 2)
+	for _, ctx := range []context.Context{first, second} {
+		go func(ctx context.Context) {
+			_, err := client.GroupMembers(ctx, &GroupMembersRequest{GroupAlias: "group"})
+			results <- err
+		}(ctx)
+	}
+	time.Sleep(20 * time.Millisecond)
+	cancelFirst()
+	if err := <-results; err == nil {
+		t.Fatal("expected the cancelled caller to fail")
+	}
+	select {
+	case <-cancelled:
+		t.Fatal("the shared call was cancelled while a caller still waits for it")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	cancelSecond()
+	if err := <-results; err == nil {
+		t.Fatal("expected the cancelled caller to fail")
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("the shared call was not cancelled after every caller left")
+	}
+}
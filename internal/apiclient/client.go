@@ -4,19 +4,39 @@
 package apiclient
 
 import (
-	"bytes"
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"net/http"
 	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
 	BaseURL    string
 	ApiToken   string
 	HttpClient *http.Client
+	Retry      RetryConfig
+	// ReadOnly makes the client refuse every endpoint that modifies omegaUp.
+	ReadOnly bool
+	// Timeout is the time limit for each request, zero means no timeout.
+	// Requests streaming files are not limited, so large uploads on slow
+	// links are not cut off.
+	Timeout time.Duration
+
+	// Username and Password are used to log in when no ApiToken is set.
+	Username string
+	Password string
+
+	sessionMu sync.Mutex
+	authToken string
+
+	limiter *rateLimiter
+	cache   *groupCache
 }
 
 func NewClient(apiToken string, baseURL string) *Client {
@@ -27,64 +47,174 @@ func NewClient(apiToken string, baseURL string) *Client {
 		BaseURL:    baseURL,
 		ApiToken:   apiToken,
 		HttpClient: http.DefaultClient,
+		Retry:      DefaultRetryConfig(),
+		limiter:    newRateLimiter(DefaultRateLimitConfig()),
+		cache:      newGroupCache(),
 	}
 
 	return client
 }
 
-// Convert struct to map[string]string.
-func structToJson(obj interface{}) (map[string]string, error) {
-	jsonData, err := json.Marshal(obj)
-	var result map[string]string
-	if err == nil {
-		err = json.Unmarshal(jsonData, &result)
-	}
-	return result, err
+// SetRateLimit replaces the client side rate limits. It must be called before
+// the client is used.
+func (c *Client) SetRateLimit(config RateLimitConfig) {
+	c.limiter = newRateLimiter(config)
 }
 
-func (c *Client) query(endpoint string, payload interface{}) ([]byte, error) {
+func (c *Client) query(ctx context.Context, endpoint string, payload interface{}) ([]byte, error) {
+	return c.queryWithFiles(ctx, endpoint, payload, nil)
+}
+
+// queryWithFiles sends a request with files attached. Files are streamed, so
+// large problem packages are never fully buffered.
+func (c *Client) queryWithFiles(ctx context.Context, endpoint string, payload interface{}, files []File) ([]byte, error) {
+	if c.ReadOnly && !isReadEndpoint(endpoint) {
+		return nil, &ReadOnlyError{Endpoint: endpoint}
+	}
 	fullURL, err := url.Parse(c.BaseURL + endpoint)
 	if err != nil {
 		return nil, err
 	}
-	payloadJson, err := structToJson(payload)
+	fields, err := encodeForm(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	for key, val := range payloadJson {
-		_ = writer.WriteField(key, val)
+	ctx = tflog.SetField(ctx, "omegaup_endpoint", endpoint)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "password", "api_token", "auth_token", "Authorization")
+	tflog.Trace(ctx, "OmegaUp API request payload", map[string]interface{}{
+		"payload": redactFields(fields),
+	})
+
+	var body *requestBody
+	if len(files) == 0 {
+		body, err = newFormBody(fields)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		body = newStreamingBody(fields, files)
+	}
+
+	for attempt := 1; ; attempt++ {
+		result, resp, err := c.send(ctx, endpoint, fullURL, body)
+		if err == nil {
+			return result, nil
+		}
+
+		statusCode := 0
+		if resp != nil {
+			statusCode = resp.StatusCode
+		} else if apiErr, ok := asAPIError(err); ok {
+			// The request failed before being sent, e.g. while logging in.
+			statusCode = apiErr.HTTPStatus
+		}
+		if attempt > c.Retry.MaxRetries || ctx.Err() != nil || errors.Is(err, errBodyNotReplayable) || !shouldRetry(endpoint, statusCode) {
+			tflog.Debug(ctx, "OmegaUp API request failed", map[string]interface{}{
+				"attempts": attempt,
+				"error":    err.Error(),
+			})
+			if attempt > 1 {
+				return nil, fmt.Errorf("%w (after %d attempts)", err, attempt)
+			}
+			return nil, err
+		}
+
+		wait := c.Retry.backoff(attempt)
+		if after, ok := retryAfter(resp); ok {
+			wait = after
+		}
+		tflog.Debug(ctx, "Retrying OmegaUp API request", map[string]interface{}{
+			"attempt": attempt,
+			"status":  statusCode,
+			"wait":    wait.String(),
+			"error":   err.Error(),
+		})
+		if err := sleep(ctx, wait); err != nil {
+			return nil, fmt.Errorf("%w (after %d attempts)", err, attempt)
+		}
 	}
-	writer.Close()
+}
 
-	req, err := http.NewRequest("POST", fullURL.String(), body)
+// send sends a single attempt of a request. The response is returned
+// alongside the error so the caller can inspect the status code and headers.
+// If the session expired, it logs in again and repeats the request once.
+func (c *Client) send(ctx context.Context, endpoint string, fullURL *url.URL, body *requestBody) ([]byte, *http.Response, error) {
+	result, resp, token, err := c.do(ctx, endpoint, fullURL, body)
+	if token != "" && IsUnauthorized(err) {
+		c.expireSession(token)
+		result, resp, _, err = c.do(ctx, endpoint, fullURL, body)
+	}
+	return result, resp, err
+}
+
+func (c *Client) do(ctx context.Context, endpoint string, fullURL *url.URL, body *requestBody) ([]byte, *http.Response, string, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", fullURL.String(), http.NoBody)
 	if err != nil {
-		return nil, err
+		return nil, nil, "", err
+	}
+	req.Header.Set("Content-Type", body.contentType)
+	token, err := c.authenticate(ctx, endpoint, req)
+	if err != nil {
+		return nil, nil, "", err
 	}
-	req.Header.Set("Content-Type", writer.FormDataContentType())
-	req.Header.Set("Authorization", fmt.Sprintf("token %s", c.ApiToken))
+
+	release, err := c.limiter.acquire(ctx)
+	if err != nil {
+		return nil, nil, token, err
+	}
+	defer release()
+
+	// Waiting for the rate limiter does not count towards the timeout.
+	if c.Timeout > 0 && body.length >= 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+		req = req.WithContext(ctx)
+	}
+
+	// The body is only opened once the request can be sent, so streamed
+	// files are not held open while waiting for the rate limiter.
+	req.Body, err = body.open(ctx)
+	if err != nil {
+		return nil, nil, token, err
+	}
+	req.ContentLength = body.length
+
+	tflog.Trace(ctx, "Sending OmegaUp API request", map[string]interface{}{
+		"url":     fullURL.String(),
+		"headers": redactHeaders(req.Header),
+	})
+	start := time.Now()
 
 	resp, err := c.HttpClient.Do(req)
 	if err != nil {
-		return nil, err
+		tflog.Debug(ctx, "OmegaUp API request error", map[string]interface{}{
+			"latency_ms": time.Since(start).Milliseconds(),
+			"error":      err.Error(),
+		})
+		return nil, nil, token, err
 	}
 	defer resp.Body.Close()
+	c.limiter.observe(resp)
 
 	result, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, resp, token, err
 	}
 
+	tflog.Debug(ctx, "OmegaUp API response", map[string]interface{}{
+		"status":        resp.StatusCode,
+		"latency_ms":    time.Since(start).Milliseconds(),
+		"response_size": len(result),
+	})
+	tflog.Trace(ctx, "OmegaUp API response body", map[string]interface{}{
+		"body": redactJSON(string(result)),
+	})
+
 	if resp.StatusCode != http.StatusOK {
-		// err is the error contained in json
-		var errResponse map[string]string
-		if err := json.Unmarshal(result, &errResponse); err != nil && errResponse["error"] != "" {
-			return nil, fmt.Errorf("endpoint: %v, status: %v, error: %s", endpoint, resp.StatusCode, errResponse["error"])
-		}
-		return nil, fmt.Errorf("%v", result)
+		return nil, resp, token, newAPIError(endpoint, resp.StatusCode, result)
 	}
 
-	return result, nil
+	return result, resp, token, nil
 }
@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+// endpointInfo classifies an endpoint for the read only mode and retries.
+type endpointInfo struct {
+	// read endpoints never modify omegaUp, and are allowed in read only mode.
+	read bool
+	// idempotent endpoints can be safely sent more than once, even if the
+	// server already processed a previous attempt.
+	idempotent bool
+}
+
+// Every endpoint called by the client, by full path. Endpoints that are not
+// listed are treated as non idempotent writes. Logging in only creates a
+// session, so it is allowed in read only mode, but it is not retried. Some
+// updates are not idempotent either: /api/identity/update renames through
+// original_username and /api/problem/update creates a new problem version.
+var endpoints = map[string]endpointInfo{
+	loginEndpoint:                  {read: true},
+	"/api/group/details":           {read: true, idempotent: true},
+	"/api/group/list":              {read: true, idempotent: true},
+	"/api/group/members":           {read: true, idempotent: true},
+	"/api/group/myList":            {read: true, idempotent: true},
+	"/api/group/update":            {idempotent: true},
+	"/api/groupScoreboard/details": {read: true, idempotent: true},
+	"/api/identity/changePassword": {idempotent: true},
+	"/api/session/currentSession":  {read: true, idempotent: true},
+	"/api/teamsGroup/details":      {read: true, idempotent: true},
+	"/api/teamsGroup/teams":        {read: true, idempotent: true},
+	"/api/teamsGroup/teamsMembers": {read: true, idempotent: true},
+	"/api/teamsGroup/update":       {idempotent: true},
+}
+
+func isReadEndpoint(endpoint string) bool {
+	return endpoints[endpoint].read
+}
+
+func isIdempotent(endpoint string) bool {
+	return endpoints[endpoint].idempotent
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned when omegaUp answers with a non 200 status code.
+type APIError struct {
+	Endpoint   string `json:"-"`
+	HTTPStatus int    `json:"-"`
+	Status     string `json:"status"`
+	Message    string `json:"error"`
+	ErrorName  string `json:"errorname"`
+	Parameter  string `json:"parameter"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("endpoint: %s, status: %d", e.Endpoint, e.HTTPStatus)
+	if e.ErrorName != "" {
+		msg += fmt.Sprintf(", errorname: %s", e.ErrorName)
+	}
+	if e.Parameter != "" {
+		msg += fmt.Sprintf(", parameter: %s", e.Parameter)
+	}
+	if e.Message != "" {
+		msg += fmt.Sprintf(", error: %s", e.Message)
+	}
+	return msg
+}
+
+func newAPIError(endpoint string, httpStatus int, body []byte) *APIError {
+	apiErr := &APIError{}
+	if err := json.Unmarshal(body, apiErr); err != nil {
+		// Not a JSON error, keep whatever the server sent.
+		apiErr = &APIError{Message: strings.TrimSpace(string(body))}
+	}
+	apiErr.Endpoint = endpoint
+	apiErr.HTTPStatus = httpStatus
+	return apiErr
+}
+
+// Error names used by omegaUp for objects that do not exist.
+var notFoundErrorNames = map[string]struct{}{
+	"resourceNotFound":       {},
+	"groupNotFound":          {},
+	"userNotExist":           {},
+	"invalidGroupScoreboard": {},
+}
+
+// Error names used by omegaUp when creating something that already exists.
+var alreadyExistsErrorNames = map[string]struct{}{
+	"aliasInUse":       {},
+	"usernameInUse":    {},
+	"identityInGroup":  {},
+	"duplicatedEntry":  {},
+	"teamMemberExists": {},
+}
+
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound reports whether err is an omegaUp error for a missing object.
+func IsNotFound(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	if _, exists := notFoundErrorNames[apiErr.ErrorName]; exists {
+		return true
+	}
+	// A bare 404 can also come from a proxy or a wrong base URL, which must
+	// not be mistaken for a deleted object. Only trust omegaUp JSON errors.
+	return apiErr.HTTPStatus == http.StatusNotFound && apiErr.Status == "error"
+}
+
+// IsForbidden reports whether err is an omegaUp error for a missing permission.
+func IsForbidden(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	return apiErr.HTTPStatus == http.StatusForbidden || apiErr.ErrorName == "userNotAllowed"
+}
+
+// IsUnauthorized reports whether err is an omegaUp error for a missing or
+// expired session.
+func IsUnauthorized(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	return apiErr.HTTPStatus == http.StatusUnauthorized || apiErr.ErrorName == "loginRequired"
+}
+
+// IsAlreadyExists reports whether err is an omegaUp error for a duplicated object.
+func IsAlreadyExists(err error) bool {
+	apiErr, ok := asAPIError(err)
+	if !ok {
+		return false
+	}
+	_, exists := alreadyExistsErrorNames[apiErr.ErrorName]
+	return exists
+}
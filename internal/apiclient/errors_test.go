@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQueryReturnsAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"status":"error","error":"Group not found","errorname":"resourceNotFound","parameter":"group_alias"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("token", server.URL)
+	_, err := client.query(context.Background(), "/api/group/create", map[string]string{})
+
+	apiErr, ok := asAPIError(fmt.Errorf("wrapped: %w", err))
+	if !ok {
+		t.Fatalf("expected an APIError, got: %v", err)
+	}
+	if apiErr.HTTPStatus != http.StatusNotFound || apiErr.ErrorName != "resourceNotFound" || apiErr.Parameter != "group_alias" {
+		t.Errorf("unexpected error fields: %+v", apiErr)
+	}
+	if !IsNotFound(err) || IsForbidden(err) || IsAlreadyExists(err) {
+		t.Errorf("unexpected classification for: %v", err)
+	}
+}
+
+func TestQueryReturnsAPIErrorForPlainBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "forbidden", http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client := NewClient("token", server.URL)
+	_, err := client.query(context.Background(), "/api/group/create", map[string]string{})
+	if !IsForbidden(err) {
+		t.Fatalf("expected a forbidden error, got: %v", err)
+	}
+	if apiErr, _ := asAPIError(err); apiErr.Message != "forbidden" {
+		t.Errorf("expected the raw body as message, got: %q", apiErr.Message)
+	}
+}
+
+func TestQueryDoesNotTreatPlain404AsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`<html><body>404 Not Found</body></html>`))
+	}))
+	defer server.Close()
+
+	client := NewClient("token", server.URL)
+	_, err := client.query(context.Background(), "/api/group/details", map[string]string{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if IsNotFound(err) {
+		t.Errorf("a non omegaUp 404 must not be reported as not found: %v", err)
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// formField is a single multipart form value.
+type formField struct {
+	Name  string
+	Value string
+}
+
+// encodeForm converts a request into the form fields omegaUp expects.
+//
+// Field names are taken from the json tags, and `omitempty` and `-` are
+// honoured. Nil pointers are omitted, so optional parameters can be modelled
+// with pointer fields. Scalars are sent as plain text, booleans as
+// "true"/"false", slices of scalars are comma-joined and any other slice,
+// map or struct is sent JSON encoded. Fields keep the declaration order of
+// the struct, and map keys are sorted, so the output is deterministic.
+func encodeForm(payload interface{}) ([]formField, error) {
+	v := reflect.ValueOf(payload)
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, nil
+		}
+		v = v.Elem()
+	}
+
+	fields := []formField{}
+	switch v.Kind() {
+	case reflect.Struct:
+		if err := appendStructFields(&fields, v); err != nil {
+			return nil, err
+		}
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("unsupported form payload type: %s", v.Type())
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			value, ok, err := encodeFormValue(v.MapIndex(key))
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %w", key.String(), err)
+			}
+			if ok {
+				fields = append(fields, formField{Name: key.String(), Value: value})
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported form payload type: %s", v.Type())
+	}
+	return fields, nil
+}
+
+func appendStructFields(fields *[]formField, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+
+		name, omitEmpty, skip := parseFieldTag(field)
+		if skip {
+			continue
+		}
+
+		// Embedded structs without a name are flattened, like encoding/json does.
+		if field.Anonymous && name == "" {
+			for value.Kind() == reflect.Pointer {
+				if value.IsNil() {
+					break
+				}
+				value = value.Elem()
+			}
+			if value.Kind() == reflect.Struct {
+				if err := appendStructFields(fields, value); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		if omitEmpty && value.IsZero() {
+			continue
+		}
+
+		encoded, ok, err := encodeFormValue(value)
+		if err != nil {
+			return fmt.Errorf("field %s: %w", name, err)
+		}
+		if ok {
+			*fields = append(*fields, formField{Name: name, Value: encoded})
+		}
+	}
+	return nil
+}
+
+func parseFieldTag(field reflect.StructField) (name string, omitEmpty bool, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		if option == "omitempty" {
+			omitEmpty = true
+		}
+	}
+	return parts[0], omitEmpty, false
+}
+
+// encodeFormValue returns the textual form of a value, and false if the value
+// must be omitted.
+func encodeFormValue(v reflect.Value) (string, bool, error) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return "", false, nil
+		}
+		v = v.Elem()
+	}
+
+	if value, ok := encodeScalar(v); ok {
+		return value, true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return "", false, nil
+		}
+		if isScalarKind(v.Type().Elem().Kind()) {
+			values := make([]string, 0, v.Len())
+			for i := 0; i < v.Len(); i++ {
+				value, _ := encodeScalar(v.Index(i))
+				values = append(values, value)
+			}
+			return strings.Join(values, ","), true, nil
+		}
+	case reflect.Map:
+		if v.IsNil() {
+			return "", false, nil
+		}
+	case reflect.Struct:
+	default:
+		return "", false, fmt.Errorf("unsupported type %s", v.Type())
+	}
+
+	encoded, err := json.Marshal(v.Interface())
+	if err != nil {
+		return "", false, err
+	}
+	return string(encoded), true, nil
+}
+
+func isScalarKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
+
+func encodeScalar(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 32), true
+	case reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	}
+	return "", false
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"reflect"
+	"testing"
+)
+
+type formTestNested struct {
+	Alias string `json:"alias"`
+}
+
+type formTestBase struct {
+	GroupAlias string `json:"group_alias"`
+}
+
+type formTestRequest struct {
+	formTestBase
+	WindowLength   int              `json:"window_length"`
+	ShowScoreboard bool             `json:"show_scoreboard"`
+	Weight         float64          `json:"weight"`
+	Languages      []string         `json:"languages"`
+	Description    *string          `json:"description"`
+	Name           string           `json:"name,omitempty"`
+	Contests       []formTestNested `json:"contests"`
+	Ignored        string           `json:"-"`
+}
+
+func TestEncodeFormStruct(t *testing.T) {
+	fields, err := encodeForm(&formTestRequest{
+		formTestBase:   formTestBase{GroupAlias: "group"},
+		WindowLength:   60,
+		ShowScoreboard: true,
+		Weight:         0.5,
+		Languages:      []string{"cpp17-gcc", "py3"},
+		Contests:       []formTestNested{{Alias: "contest"}},
+		Ignored:        "ignored",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []formField{
+		{Name: "group_alias", Value: "group"},
+		{Name: "window_length", Value: "60"},
+		{Name: "show_scoreboard", Value: "true"},
+		{Name: "weight", Value: "0.5"},
+		{Name: "languages", Value: "cpp17-gcc,py3"},
+		{Name: "contests", Value: `[{"alias":"contest"}]`},
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("unexpected fields:\n got: %v\nwant: %v", fields, expected)
+	}
+}
+
+func TestEncodeFormMap(t *testing.T) {
+	fields, err := encodeForm(map[string]string{"b": "2", "a": "1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []formField{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Errorf("unexpected fields:\n got: %v\nwant: %v", fields, expected)
+	}
+}
+
+func TestEncodeFormRejectsScalars(t *testing.T) {
+	if _, err := encodeForm("payload"); err == nil {
+		t.Fatal("expected an error")
+	}
+}
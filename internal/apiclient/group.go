@@ -3,7 +3,10 @@
 
 package apiclient
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
 
 type Group struct {
 	Alias       string `json:"alias"`
@@ -11,8 +14,8 @@ type Group struct {
 	Name        string `json:"name"`
 }
 
-func (c *Client) GroupCreate(req *GroupCreateRequest) error {
-	_, err := c.query("/api/group/create", req)
+func (c *Client) GroupCreate(ctx context.Context, req *GroupCreateRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.Alias, "/api/group/create", req)
 	if err != nil {
 		return err
 	}
@@ -21,9 +24,9 @@ func (c *Client) GroupCreate(req *GroupCreateRequest) error {
 
 type GroupCreateRequest Group
 
-func (c *Client) GroupDetails(req *GroupDetailsRequest) (*GroupDetailsResponse, error) {
+func (c *Client) GroupDetails(ctx context.Context, req *GroupDetailsRequest) (*GroupDetailsResponse, error) {
 	var res *GroupDetailsResponse
-	bytes, err := c.query("/api/group/details", req)
+	bytes, err := c.queryGroupRead(ctx, req.GroupAlias, "/api/group/details", req)
 	if err != nil {
 		return nil, err
 	}
@@ -48,15 +51,15 @@ type GroupDetailsResponse struct {
 	Group GroupDetailsResponseGroup `json:"group"`
 }
 
-func (c *Client) GroupUpdate(req *GroupUpdateRequest) error {
-	_, err := c.query("/api/group/update", req)
+func (c *Client) GroupUpdate(ctx context.Context, req *GroupUpdateRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.Alias, "/api/group/update", req)
 	return err
 }
 
 type GroupUpdateRequest Group
 
-func (c *Client) GroupAddUser(req *GroupAddUserRequest) error {
-	_, err := c.query("/api/group/addUser", req)
+func (c *Client) GroupAddUser(ctx context.Context, req *GroupAddUserRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.GroupAlias, "/api/group/addUser", req)
 	return err
 }
 
@@ -65,9 +68,9 @@ type GroupAddUserRequest struct {
 	UsernameOrEmail string `json:"usernameOrEmail"`
 }
 
-func (c *Client) GroupMembers(req *GroupMembersRequest) (*GroupMembersResponse, error) {
+func (c *Client) GroupMembers(ctx context.Context, req *GroupMembersRequest) (*GroupMembersResponse, error) {
 	var res *GroupMembersResponse
-	bytes, err := c.query("/api/group/members", req)
+	bytes, err := c.queryGroupRead(ctx, req.GroupAlias, "/api/group/members", req)
 	if err != nil {
 		return nil, err
 	}
@@ -99,9 +102,48 @@ type GroupMembersResponse struct {
 	Identities []GroupIdentity `json:"identities"`
 }
 
-func (c *Client) GroupRemoveUser(req *GroupRemoveUserRequest) error {
-	_, err := c.query("/api/group/removeUser", req)
+func (c *Client) GroupRemoveUser(ctx context.Context, req *GroupRemoveUserRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.GroupAlias, "/api/group/removeUser", req)
 	return err
 }
 
 type GroupRemoveUserRequest GroupAddUserRequest
+
+func (c *Client) GroupMyList(ctx context.Context) (*GroupMyListResponse, error) {
+	var res *GroupMyListResponse
+	bytes, err := c.query(ctx, "/api/group/myList", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type GroupMyListResponse struct {
+	Groups []GroupDetailsResponseGroup `json:"groups"`
+}
+
+func (c *Client) GroupList(ctx context.Context, req *GroupListRequest) ([]GroupListItem, error) {
+	var res []GroupListItem
+	bytes, err := c.query(ctx, "/api/group/list", req)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type GroupListRequest struct {
+	Query string `json:"query"`
+}
+
+type GroupListItem struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
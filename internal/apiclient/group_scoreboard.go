@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type GroupScoreboard struct {
+	GroupAlias  string `json:"group_alias"`
+	Alias       string `json:"alias"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (c *Client) GroupScoreboardCreate(ctx context.Context, req *GroupScoreboardCreateRequest) error {
+	_, err := c.query(ctx, "/api/groupScoreboard/create", req)
+	return err
+}
+
+type GroupScoreboardCreateRequest GroupScoreboard
+
+func (c *Client) GroupScoreboardAddContest(ctx context.Context, req *GroupScoreboardAddContestRequest) error {
+	_, err := c.query(ctx, "/api/groupScoreboard/addContest", req)
+	return err
+}
+
+type GroupScoreboardAddContestRequest struct {
+	GroupAlias      string  `json:"group_alias"`
+	ScoreboardAlias string  `json:"scoreboard_alias"`
+	ContestAlias    string  `json:"contest_alias"`
+	Weight          float64 `json:"weight"`
+	OnlyAC          bool    `json:"only_ac"`
+}
+
+func (c *Client) GroupScoreboardRemoveContest(ctx context.Context, req *GroupScoreboardRemoveContestRequest) error {
+	_, err := c.query(ctx, "/api/groupScoreboard/removeContest", req)
+	return err
+}
+
+type GroupScoreboardRemoveContestRequest struct {
+	GroupAlias      string `json:"group_alias"`
+	ScoreboardAlias string `json:"scoreboard_alias"`
+	ContestAlias    string `json:"contest_alias"`
+}
+
+func (c *Client) GroupScoreboardDetails(ctx context.Context, req *GroupScoreboardDetailsRequest) (*GroupScoreboardDetailsResponse, error) {
+	var res *GroupScoreboardDetailsResponse
+	bytes, err := c.query(ctx, "/api/groupScoreboard/details", req)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type GroupScoreboardDetailsRequest struct {
+	GroupAlias      string `json:"group_alias"`
+	ScoreboardAlias string `json:"scoreboard_alias"`
+}
+
+type GroupScoreboardDetailsResponseScoreboard struct {
+	Alias       string `json:"alias"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	CreateTime  int    `json:"create_time"`
+}
+
+type GroupScoreboardContest struct {
+	Alias  string  `json:"alias"`
+	Title  string  `json:"title"`
+	Weight float64 `json:"weight"`
+	OnlyAC bool    `json:"only_ac"`
+}
+
+type GroupScoreboardScore struct {
+	Points  float64 `json:"points"`
+	Penalty float64 `json:"penalty"`
+}
+
+// GroupScoreboardContestScores are the scores of a user indexed by contest
+// alias.
+type GroupScoreboardContestScores map[string]GroupScoreboardScore
+
+// UnmarshalJSON accepts an empty list as well, since that is how omegaUp
+// encodes an empty map.
+func (s *GroupScoreboardContestScores) UnmarshalJSON(data []byte) error {
+	var list []GroupScoreboardScore
+	if err := json.Unmarshal(data, &list); err == nil && len(list) == 0 {
+		*s = GroupScoreboardContestScores{}
+		return nil
+	}
+	var scores map[string]GroupScoreboardScore
+	if err := json.Unmarshal(data, &scores); err != nil {
+		return err
+	}
+	*s = scores
+	return nil
+}
+
+type GroupScoreboardRankingEntry struct {
+	Username  string                       `json:"username"`
+	Name      string                       `json:"name"`
+	IsInvited bool                         `json:"is_invited"`
+	Contests  GroupScoreboardContestScores `json:"contests"`
+	Total     GroupScoreboardScore         `json:"total"`
+}
+
+type GroupScoreboardDetailsResponse struct {
+	Scoreboard GroupScoreboardDetailsResponseScoreboard `json:"scoreboard"`
+	Contests   []GroupScoreboardContest                 `json:"contests"`
+	Ranking    []GroupScoreboardRankingEntry            `json:"ranking"`
+}
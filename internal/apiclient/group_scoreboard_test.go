@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGroupScoreboardDetailsRanking(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{
+			"scoreboard": {"alias": "league", "name": "League", "description": "", "create_time": 1700000000},
+			"contests": [{"alias": "round-1", "title": "Round 1", "weight": 2, "only_ac": true}],
+			"ranking": [
+				{
+					"username": "alice",
+					"name": "Alice",
+					"is_invited": true,
+					"contests": {"round-1": {"points": 200, "penalty": 35}},
+					"total": {"points": 200, "penalty": 35}
+				},
+				{
+					"username": "bob",
+					"name": null,
+					"is_invited": true,
+					"contests": [],
+					"total": {"points": 0, "penalty": 0}
+				}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("token", server.URL)
+	res, err := client.GroupScoreboardDetails(context.Background(), &GroupScoreboardDetailsRequest{
+		GroupAlias:      "group",
+		ScoreboardAlias: "league",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(res.Contests) != 1 || res.Contests[0].Weight != 2 || !res.Contests[0].OnlyAC {
+		t.Errorf("unexpected contests: %+v", res.Contests)
+	}
+	if len(res.Ranking) != 2 {
+		t.Fatalf("expected 2 ranking entries, got %d", len(res.Ranking))
+	}
+	if score := res.Ranking[0].Contests["round-1"]; score.Points != 200 || score.Penalty != 35 {
+		t.Errorf("unexpected score for alice: %+v", score)
+	}
+	if res.Ranking[1].Contests == nil || len(res.Ranking[1].Contests) != 0 {
+		t.Errorf("expected no scores for bob, got %+v", res.Ranking[1].Contests)
+	}
+}
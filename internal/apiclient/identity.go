@@ -4,6 +4,7 @@
 package apiclient
 
 import (
+	"context"
 	"encoding/json"
 	"strings"
 )
@@ -23,9 +24,9 @@ func EqualUsername(u1 string, u2 string) bool {
 	return strings.EqualFold(u1, u2)
 }
 
-func (c *Client) IdentityCreate(req *IdentityCreateRequest) (*IdentityCreateResponse, error) {
+func (c *Client) IdentityCreate(ctx context.Context, req *IdentityCreateRequest) (*IdentityCreateResponse, error) {
 	var res *IdentityCreateResponse
-	bytes, err := c.query("/api/identity/create", req)
+	bytes, err := c.queryGroupWrite(ctx, req.GroupAlias, "/api/identity/create", req)
 	if err != nil {
 		return nil, err
 	}
@@ -42,8 +43,8 @@ type IdentityCreateResponse struct {
 	Username string `json:"username"`
 }
 
-func (c *Client) IdentityUpdate(req *IdentityUpdateRequest) error {
-	_, err := c.query("/api/identity/update", req)
+func (c *Client) IdentityUpdate(ctx context.Context, req *IdentityUpdateRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.GroupAlias, "/api/identity/update", req)
 	return err
 }
 
@@ -58,8 +59,8 @@ type IdentityUpdateRequest struct {
 	StateId          string `json:"state_id"`
 }
 
-func (c *Client) IdentityChangePassword(req *IdentityChangePasswordRequest) error {
-	_, err := c.query("/api/identity/changePassword", req)
+func (c *Client) IdentityChangePassword(ctx context.Context, req *IdentityChangePasswordRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.GroupAlias, "/api/identity/changePassword", req)
 	return err
 }
 
@@ -69,20 +70,45 @@ type IdentityChangePasswordRequest struct {
 	Password   string `json:"password"`
 }
 
-func (c *Client) IdentityBulkCreate(req *IdentityBulkCreateRequest) error {
-	identitiesJson, err := json.Marshal(req.Identities)
-	if err != nil {
-		return err
-	}
-
-	_, err = c.query("/api/identity/bulkCreate", map[string]string{
-		"group_alias": req.GroupAlias,
-		"identities":  string(identitiesJson),
-	})
+func (c *Client) IdentityBulkCreate(ctx context.Context, req *IdentityBulkCreateRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.GroupAlias, "/api/identity/bulkCreate", req)
 	return err
 }
 
 type IdentityBulkCreateRequest struct {
-	GroupAlias string
-	Identities []Identity
+	GroupAlias string     `json:"group_alias"`
+	Identities []Identity `json:"identities"`
 }
+
+// TeamIdentity is an identity that represents a team, together with the
+// usernames of its members.
+type TeamIdentity struct {
+	Username   string `json:"username"`
+	Name       string `json:"name"`
+	Gender     string `json:"gender"`
+	Password   string `json:"password"`
+	SchoolName string `json:"school_name"`
+	CountryId  string `json:"country_id"`
+	StateId    string `json:"state_id"`
+	// Usernames of the members, separated by commas.
+	Usernames string `json:"usernames"`
+}
+
+func (c *Client) IdentityBulkCreateForTeams(ctx context.Context, req *IdentityBulkCreateForTeamsRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.TeamGroupAlias, "/api/identity/bulkCreateForTeams", req)
+	return err
+}
+
+type IdentityBulkCreateForTeamsRequest struct {
+	TeamGroupAlias string         `json:"team_group_alias"`
+	TeamIdentities []TeamIdentity `json:"team_identities"`
+}
+
+func (c *Client) IdentityUpdateIdentityTeam(ctx context.Context, req *IdentityUpdateIdentityTeamRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.GroupAlias, "/api/identity/updateIdentityTeam", req)
+	return err
+}
+
+// IdentityUpdateIdentityTeamRequest updates a team identity, GroupAlias is
+// the alias of its teams group.
+type IdentityUpdateIdentityTeamRequest IdentityUpdateRequest
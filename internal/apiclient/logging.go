@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+const redacted = "***"
+
+// Form fields and headers whose values must never reach the logs.
+var sensitiveKeys = map[string]struct{}{
+	"password":      {},
+	"api_token":     {},
+	"auth_token":    {},
+	"authorization": {},
+	"cookie":        {},
+}
+
+// Matches sensitive values nested in JSON encoded fields, e.g. the
+// identities sent to bulkCreate or the auth token returned by login.
+var sensitiveJSONPattern = regexp.MustCompile(`("(?:password|api_token|auth_token)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+func isSensitive(key string) bool {
+	_, exists := sensitiveKeys[strings.ToLower(key)]
+	return exists
+}
+
+// redactJSON masks sensitive values in a JSON document.
+func redactJSON(value string) string {
+	return sensitiveJSONPattern.ReplaceAllString(value, `$1"`+redacted+`"`)
+}
+
+// redactFields returns a copy of the form fields safe to be logged.
+func redactFields(fields []formField) map[string]string {
+	result := make(map[string]string, len(fields))
+	for _, field := range fields {
+		if isSensitive(field.Name) {
+			result[field.Name] = redacted
+		} else {
+			result[field.Name] = redactJSON(field.Value)
+		}
+	}
+	return result
+}
+
+// redactHeaders returns a copy of the headers safe to be logged.
+func redactHeaders(headers http.Header) map[string]string {
+	result := make(map[string]string, len(headers))
+	for key := range headers {
+		if isSensitive(key) {
+			result[key] = redacted
+		} else {
+			result[key] = headers.Get(key)
+		}
+	}
+	return result
+}
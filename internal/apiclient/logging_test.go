@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactFields(t *testing.T) {
+	fields := redactFields([]formField{
+		{Name: "group_alias", Value: "group"},
+		{Name: "password", Value: "secret"},
+		{Name: "identities", Value: `[{"username":"group:user","password":"secret"}]`},
+	})
+	if fields["group_alias"] != "group" {
+		t.Errorf("unexpected group_alias: %q", fields["group_alias"])
+	}
+	if fields["password"] != redacted {
+		t.Errorf("password was not redacted: %q", fields["password"])
+	}
+	if strings.Contains(fields["identities"], "secret") || !strings.Contains(fields["identities"], "group:user") {
+		t.Errorf("unexpected identities: %q", fields["identities"])
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "token secret")
+	headers.Set("Content-Type", "multipart/form-data")
+	result := redactHeaders(headers)
+	if result["Authorization"] != redacted || result["Content-Type"] != "multipart/form-data" {
+		t.Errorf("unexpected headers: %v", result)
+	}
+}
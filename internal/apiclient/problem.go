@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+)
+
+// Name of the form field carrying the problem package.
+const problemContentsField = "problem_contents"
+
+func (c *Client) ProblemCreate(ctx context.Context, req *ProblemCreateRequest, contents File) error {
+	contents.FieldName = problemContentsField
+	_, err := c.queryWithFiles(ctx, "/api/problem/create", req, []File{contents})
+	return err
+}
+
+type ProblemCreateRequest struct {
+	ProblemAlias        string   `json:"problem_alias"`
+	Title               string   `json:"title"`
+	Source              string   `json:"source,omitempty"`
+	Visibility          string   `json:"visibility,omitempty"`
+	Languages           []string `json:"languages,omitempty"`
+	Validator           string   `json:"validator,omitempty"`
+	TimeLimit           *int     `json:"time_limit,omitempty"`
+	ExtraWallTime       *int     `json:"extra_wall_time,omitempty"`
+	MemoryLimit         *int     `json:"memory_limit,omitempty"`
+	OutputLimit         *int     `json:"output_limit,omitempty"`
+	InputLimit          *int     `json:"input_limit,omitempty"`
+	EmailClarifications *bool    `json:"email_clarifications,omitempty"`
+	ShowDiff            string   `json:"show_diff,omitempty"`
+	ProblemLevel        string   `json:"problem_level,omitempty"`
+}
+
+// ProblemUpdate updates the problem settings. If contents is not nil, a new
+// version of the problem package is uploaded as well.
+func (c *Client) ProblemUpdate(ctx context.Context, req *ProblemUpdateRequest, contents *File) error {
+	var files []File
+	if contents != nil {
+		file := *contents
+		file.FieldName = problemContentsField
+		files = append(files, file)
+	}
+	_, err := c.queryWithFiles(ctx, "/api/problem/update", req, files)
+	return err
+}
+
+type ProblemUpdateRequest struct {
+	ProblemCreateRequest
+	Message         string `json:"message"`
+	UpdatePublished string `json:"update_published,omitempty"`
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimitConfig controls how many requests the client sends to omegaUp.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained request rate. Zero disables the limit.
+	RequestsPerSecond float64
+	// MaxParallel is the maximum number of requests in flight. Zero disables
+	// the limit.
+	MaxParallel int
+}
+
+func DefaultRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		RequestsPerSecond: 10,
+		MaxParallel:       4,
+	}
+}
+
+// rateLimiter combines a token bucket with a semaphore, and pauses every
+// request when omegaUp reports through the X-RateLimit-* headers that the
+// quota is exhausted.
+type rateLimiter struct {
+	limiter *rate.Limiter
+	slots   chan struct{}
+
+	mu          sync.Mutex
+	pausedUntil time.Time
+}
+
+func newRateLimiter(config RateLimitConfig) *rateLimiter {
+	l := &rateLimiter{}
+	if config.RequestsPerSecond > 0 {
+		burst := int(math.Max(1, math.Floor(config.RequestsPerSecond)))
+		l.limiter = rate.NewLimiter(rate.Limit(config.RequestsPerSecond), burst)
+	}
+	if config.MaxParallel > 0 {
+		l.slots = make(chan struct{}, config.MaxParallel)
+	}
+	return l
+}
+
+// acquire blocks until a request can be sent. The returned function must be
+// called once the request finishes.
+func (l *rateLimiter) acquire(ctx context.Context) (func(), error) {
+	l.mu.Lock()
+	pause := time.Until(l.pausedUntil)
+	l.mu.Unlock()
+	if pause > 0 {
+		if err := sleep(ctx, pause); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// observe adapts to the X-RateLimit-Remaining and X-RateLimit-Reset headers.
+// Once no requests remain, every request waits until the reset time.
+func (l *rateLimiter) observe(resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining"))
+	if err != nil || remaining > 0 {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil || reset <= 0 {
+		return
+	}
+
+	// The reset is either a unix timestamp or a number of seconds from now.
+	var until time.Time
+	if reset > 1_000_000_000 {
+		until = time.Unix(reset, 0)
+	} else {
+		until = time.Now().Add(time.Duration(reset) * time.Second)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
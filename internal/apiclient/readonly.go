@@ -0,0 +1,25 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ReadOnlyError is returned when a mutating endpoint is called on a read
+// only client.
+type ReadOnlyError struct {
+	Endpoint string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("refusing to call %s: the provider is configured with read_only = true, which only allows reads", e.Endpoint)
+}
+
+// IsReadOnly reports whether err was caused by the read only mode.
+func IsReadOnly(err error) bool {
+	var readOnlyErr *ReadOnlyError
+	return errors.As(err, &readOnlyErr)
+}
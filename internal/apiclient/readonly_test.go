@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestReadOnlyClientRefusesWrites(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_, _ = w.Write([]byte(`{"group":{"alias":"group"},"identities":[]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("token", server.URL)
+	client.ReadOnly = true
+	ctx := context.Background()
+
+	if _, err := client.GroupDetails(ctx, &GroupDetailsRequest{GroupAlias: "group"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.GroupMembers(ctx, &GroupMembersRequest{GroupAlias: "group"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	writes := []error{
+		client.GroupCreate(ctx, &GroupCreateRequest{Alias: "group"}),
+		client.GroupUpdate(ctx, &GroupUpdateRequest{Alias: "group"}),
+		client.GroupAddUser(ctx, &GroupAddUserRequest{GroupAlias: "group"}),
+		client.GroupRemoveUser(ctx, &GroupRemoveUserRequest{GroupAlias: "group"}),
+		client.IdentityChangePassword(ctx, &IdentityChangePasswordRequest{GroupAlias: "group"}),
+		client.IdentityBulkCreate(ctx, &IdentityBulkCreateRequest{GroupAlias: "group"}),
+	}
+	for _, err := range writes {
+		if !IsReadOnly(err) {
+			t.Errorf("expected a read only error, got: %v", err)
+		}
+	}
+	if requests != 2 {
+		t.Errorf("expected only the 2 reads to reach the server, got %d requests", requests)
+	}
+}
+
+func TestReadEndpointsAreIdempotent(t *testing.T) {
+	for endpoint, info := range endpoints {
+		if endpoint == loginEndpoint {
+			continue
+		}
+		if info.read && !info.idempotent {
+			t.Errorf("read endpoint %s must also be idempotent", endpoint)
+		}
+	}
+}
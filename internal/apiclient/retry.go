@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryConfig controls how the client retries transient failures.
+type RetryConfig struct {
+	// MaxRetries is the number of additional attempts after the first one.
+	MaxRetries int
+	// MinWait is the minimum time to wait between attempts.
+	MinWait time.Duration
+	// MaxWait is the maximum time to wait between attempts.
+	MaxWait time.Duration
+}
+
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxRetries: 3,
+		MinWait:    1 * time.Second,
+		MaxWait:    30 * time.Second,
+	}
+}
+
+// shouldRetry decides whether an attempt is worth repeating. A 429 means the
+// request was rejected before being processed, so it is retried for every
+// endpoint. Network errors (reported as a zero status code) and 5xx responses are only retried when the
+// endpoint is idempotent.
+func shouldRetry(endpoint string, statusCode int) bool {
+	if statusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !isIdempotent(endpoint) {
+		return false
+	}
+	// No response at all, the request failed at the network level.
+	if statusCode == 0 {
+		return true
+	}
+	switch statusCode {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns a jittered exponential wait for the given attempt number
+// (starting at 1).
+func (r RetryConfig) backoff(attempt int) time.Duration {
+	wait := r.MinWait
+	for i := 1; i < attempt && wait < r.MaxWait; i++ {
+		wait *= 2
+	}
+	if wait > r.MaxWait {
+		wait = r.MaxWait
+	}
+	if wait <= r.MinWait {
+		return r.MinWait
+	}
+	return r.MinWait + rand.N(wait-r.MinWait)
+}
+
+// retryAfter parses the Retry-After header, which can be either a number of
+// seconds or an HTTP date.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(url string) *Client {
+	client := NewClient("token", url)
+	client.Retry = RetryConfig{
+		MaxRetries: 2,
+		MinWait:    time.Millisecond,
+		MaxWait:    5 * time.Millisecond,
+	}
+	return client
+}
+
+func TestQueryRetriesTransientErrors(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	if _, err := client.query(context.Background(), "/api/group/details", map[string]string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestQueryReportsAttempts(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	_, err := client.query(context.Background(), "/api/group/create", map[string]string{})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Errorf("expected attempt count in error, got: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestQueryDoesNotRetryUnsafeWrites(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(server.URL)
+	if _, err := client.query(context.Background(), "/api/group/create", map[string]string{}); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Errorf("expected a single attempt, got %d", attempts)
+	}
+}
+
+func TestQueryDoesNotRetryNonIdempotentUpdates(t *testing.T) {
+	for _, endpoint := range []string{"/api/identity/update", "/api/problem/update"} {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+
+		client := newRetryTestClient(server.URL)
+		if _, err := client.query(context.Background(), endpoint, map[string]string{}); err == nil {
+			t.Errorf("%s: expected an error", endpoint)
+		}
+		if attempts != 1 {
+			t.Errorf("%s: expected a single attempt, got %d", endpoint, attempts)
+		}
+		server.Close()
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+)
+
+func (c *Client) SessionCurrentSession(ctx context.Context) (*SessionCurrentSessionResponse, error) {
+	var res *SessionCurrentSessionResponse
+	bytes, err := c.query(ctx, "/api/session/currentSession", map[string]string{})
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type SessionIdentity struct {
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+type SessionInfo struct {
+	Valid    bool             `json:"valid"`
+	Email    string           `json:"email"`
+	IsAdmin  bool             `json:"is_admin"`
+	Identity *SessionIdentity `json:"identity"`
+}
+
+type SessionCurrentSessionResponse struct {
+	Session SessionInfo `json:"session"`
+}
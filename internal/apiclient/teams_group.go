@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type TeamsGroup struct {
+	Alias               string `json:"alias"`
+	Description         string `json:"description"`
+	Name                string `json:"name"`
+	NumberOfContestants int    `json:"numberOfContestants,omitempty"`
+}
+
+func (c *Client) TeamsGroupCreate(ctx context.Context, req *TeamsGroupCreateRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.Alias, "/api/teamsGroup/create", req)
+	return err
+}
+
+type TeamsGroupCreateRequest TeamsGroup
+
+func (c *Client) TeamsGroupUpdate(ctx context.Context, req *TeamsGroupUpdateRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.Alias, "/api/teamsGroup/update", req)
+	return err
+}
+
+type TeamsGroupUpdateRequest TeamsGroup
+
+func (c *Client) TeamsGroupDetails(ctx context.Context, req *TeamsGroupDetailsRequest) (*TeamsGroupDetailsResponse, error) {
+	var res *TeamsGroupDetailsResponse
+	bytes, err := c.queryGroupRead(ctx, req.TeamGroupAlias, "/api/teamsGroup/details", req)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type TeamsGroupDetailsRequest struct {
+	TeamGroupAlias string `json:"team_group_alias"`
+}
+
+type TeamsGroupDetailsResponseTeamGroup struct {
+	Alias               string `json:"alias"`
+	Description         string `json:"description"`
+	Name                string `json:"name"`
+	NumberOfContestants int    `json:"number_of_contestants"`
+	CreateTime          int    `json:"create_time"`
+}
+
+type TeamsGroupDetailsResponse struct {
+	TeamGroup TeamsGroupDetailsResponseTeamGroup `json:"team_group"`
+}
+
+// TeamsGroupTeams returns the team identities of a teams group.
+func (c *Client) TeamsGroupTeams(ctx context.Context, req *TeamsGroupTeamsRequest) (*TeamsGroupTeamsResponse, error) {
+	var res *TeamsGroupTeamsResponse
+	bytes, err := c.queryGroupRead(ctx, req.TeamGroupAlias, "/api/teamsGroup/teams", req)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type TeamsGroupTeamsRequest struct {
+	TeamGroupAlias string `json:"team_group_alias"`
+}
+
+type TeamsGroupTeamsResponse struct {
+	Identities []GroupIdentity `json:"identities"`
+}
+
+func (c *Client) TeamsGroupRemoveTeam(ctx context.Context, req *TeamsGroupRemoveTeamRequest) error {
+	_, err := c.queryGroupWrite(ctx, req.TeamGroupAlias, "/api/teamsGroup/removeTeam", req)
+	return err
+}
+
+type TeamsGroupRemoveTeamRequest struct {
+	TeamGroupAlias  string `json:"team_group_alias"`
+	UsernameOrEmail string `json:"usernameOrEmail"`
+}
+
+func (c *Client) TeamsGroupAddMembers(ctx context.Context, req *TeamsGroupAddMembersRequest) error {
+	_, err := c.query(ctx, "/api/teamsGroup/addMembers", req)
+	return err
+}
+
+type TeamsGroupAddMembersRequest struct {
+	// TeamGroupAlias is the username of the team identity.
+	TeamGroupAlias string   `json:"team_group_alias"`
+	Usernames      []string `json:"usernames"`
+}
+
+func (c *Client) TeamsGroupRemoveMember(ctx context.Context, req *TeamsGroupRemoveMemberRequest) error {
+	_, err := c.query(ctx, "/api/teamsGroup/removeMember", req)
+	return err
+}
+
+type TeamsGroupRemoveMemberRequest struct {
+	// TeamGroupAlias is the username of the team identity.
+	TeamGroupAlias string `json:"team_group_alias"`
+	Username       string `json:"username"`
+}
+
+// TeamsGroupTeamsMembers returns a page of the members of all the teams in
+// a teams group.
+func (c *Client) TeamsGroupTeamsMembers(ctx context.Context, req *TeamsGroupTeamsMembersRequest) (*TeamsGroupTeamsMembersResponse, error) {
+	var res *TeamsGroupTeamsMembersResponse
+	bytes, err := c.query(ctx, "/api/teamsGroup/teamsMembers", req)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bytes, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+type TeamsGroupTeamsMembersRequest struct {
+	TeamGroupAlias string `json:"team_group_alias"`
+	Page           int    `json:"page,omitempty"`
+	PageSize       int    `json:"page_size,omitempty"`
+}
+
+type TeamMember struct {
+	Username  string `json:"username"`
+	Name      string `json:"name"`
+	TeamAlias string `json:"team_alias"`
+	TeamName  string `json:"team_name"`
+}
+
+type TeamsGroupTeamsMembersResponse struct {
+	PageNumber int          `json:"pageNumber"`
+	TeamsUsers []TeamMember `json:"teamsUsers"`
+	TotalRows  int          `json:"totalRows"`
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TransportConfig describes how to reach the omegaUp instance.
+type TransportConfig struct {
+	// Timeout is the time limit to wait for the response headers once the
+	// request was sent. Zero means no timeout. The time limit of the whole
+	// request is set with Client.Timeout, since it must not cut off uploads.
+	Timeout time.Duration
+	// CACertsPEM are additional PEM encoded certificate authorities trusted
+	// besides the system ones.
+	CACertsPEM [][]byte
+	// InsecureSkipVerify disables TLS certificate verification.
+	InsecureSkipVerify bool
+	// ProxyURL overrides the proxy taken from the environment.
+	ProxyURL string
+	// Headers are static headers added to every request.
+	Headers map[string]string
+}
+
+// NewHTTPClient builds a dedicated *http.Client for the given configuration.
+func NewHTTPClient(config TransportConfig) (*http.Client, error) {
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, errors.New("unexpected default transport type")
+	}
+	transport = transport.Clone()
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// #nosec G402 -- Explicitly requested by the practitioner for self-hosted instances.
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+	if len(config.CACertsPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		for _, pem := range config.CACertsPEM {
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, errors.New("no valid PEM certificates found in the CA certificate")
+			}
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport.TLSClientConfig = tlsConfig
+	transport.ResponseHeaderTimeout = config.Timeout
+
+	if config.ProxyURL != "" {
+		proxyURL, err := url.Parse(config.ProxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy url: %w", err)
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	var roundTripper http.RoundTripper = transport
+	if len(config.Headers) > 0 {
+		roundTripper = &headerTransport{
+			headers: config.Headers,
+			next:    transport,
+		}
+	}
+
+	return &http.Client{
+		Transport: roundTripper,
+	}, nil
+}
+
+// headerTransport adds static headers to every request.
+type headerTransport struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrip must not modify the original request.
+	req = req.Clone(req.Context())
+	for key, value := range t.headers {
+		req.Header.Set(key, value)
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClientAddsHeaders(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Camp") != "training" {
+			t.Errorf("missing static header, got headers: %v", r.Header)
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(TransportConfig{
+		InsecureSkipVerify: true,
+		Headers:            map[string]string{"X-Camp": "training"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := NewClient("token", server.URL)
+	client.HttpClient = httpClient
+
+	if _, err := client.query(context.Background(), "/api/group/details", map[string]string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNewHTTPClientRejectsInvalidCACert(t *testing.T) {
+	if _, err := NewHTTPClient(TransportConfig{CACertsPEM: [][]byte{[]byte("not a certificate")}}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+// slowReader returns its contents a byte at a time, waiting between reads.
+type slowReader struct {
+	contents []byte
+	delay    time.Duration
+}
+
+func (r *slowReader) Read(b []byte) (int, error) {
+	if len(r.contents) == 0 {
+		return 0, io.EOF
+	}
+	time.Sleep(r.delay)
+	b[0] = r.contents[0]
+	r.contents = r.contents[1:]
+	return 1, nil
+}
+
+func TestTimeoutDoesNotCutOffUploads(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := NewHTTPClient(TransportConfig{Timeout: 50 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := NewClient("token", server.URL)
+	client.HttpClient = httpClient
+	client.Timeout = 50 * time.Millisecond
+
+	// The upload takes longer than the timeout.
+	file := FileFromReader("problem_contents", "sumas.zip", &slowReader{
+		contents: []byte("zip contents"),
+		delay:    10 * time.Millisecond,
+	})
+	if _, err := client.queryWithFiles(context.Background(), "/api/problem/create", map[string]string{}, []File{file}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTimeoutLimitsRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient("token", server.URL)
+	client.Timeout = 50 * time.Millisecond
+	client.Retry.MaxRetries = 0
+
+	if _, err := client.query(context.Background(), "/api/group/create", map[string]string{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected a deadline exceeded error, got: %v", err)
+	}
+}
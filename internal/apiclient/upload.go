@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Log the upload progress every time this many bytes are sent.
+const uploadProgressInterval = 5 << 20
+
+// errBodyNotReplayable is returned when a request has to be sent again but
+// one of its files can only be read once.
+var errBodyNotReplayable = errors.New("request body can not be sent again")
+
+// File is a file attached to a multipart request. It is streamed to omegaUp,
+// so it is never fully loaded in memory.
+type File struct {
+	// FieldName is the name of the form field, e.g. problem_contents.
+	FieldName string
+	// FileName is the name reported to omegaUp.
+	FileName string
+	// Size is the size in bytes, used for progress logging. Zero if unknown.
+	Size int64
+	// Open returns the contents of the file. It is called once per attempt.
+	Open func() (io.ReadCloser, error)
+}
+
+// FileFromPath attaches a file from disk.
+func FileFromPath(fieldName string, path string) File {
+	file := File{
+		FieldName: fieldName,
+		FileName:  filepath.Base(path),
+		Open: func() (io.ReadCloser, error) {
+			return os.Open(path)
+		},
+	}
+	if info, err := os.Stat(path); err == nil {
+		file.Size = info.Size()
+	}
+	return file
+}
+
+// FileFromReader attaches the contents of a reader. If the reader implements
+// io.Seeker it is rewound on every attempt, otherwise the request is only
+// sent once.
+func FileFromReader(fieldName string, fileName string, r io.Reader) File {
+	used := false
+	return File{
+		FieldName: fieldName,
+		FileName:  fileName,
+		Open: func() (io.ReadCloser, error) {
+			if seeker, ok := r.(io.Seeker); ok {
+				if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+					return nil, err
+				}
+				return io.NopCloser(r), nil
+			}
+			if used {
+				return nil, errBodyNotReplayable
+			}
+			used = true
+			return io.NopCloser(r), nil
+		},
+	}
+}
+
+// requestBody builds the body of a request once per attempt.
+type requestBody struct {
+	contentType string
+	// length is the size of the body, or -1 when it is streamed.
+	length int64
+	open   func(ctx context.Context) (io.ReadCloser, error)
+}
+
+// newFormBody encodes plain fields into an in-memory multipart body.
+func newFormBody(fields []formField) (*requestBody, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for _, field := range fields {
+		if err := writer.WriteField(field.Name, field.Value); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return &requestBody{
+		contentType: writer.FormDataContentType(),
+		length:      int64(body.Len()),
+		open: func(ctx context.Context) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body.Bytes())), nil
+		},
+	}, nil
+}
+
+// newStreamingBody writes fields and files into a pipe while the request is
+// being sent.
+func newStreamingBody(fields []formField, files []File) *requestBody {
+	// Every attempt must use the same boundary as the content type.
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+	contentType := "multipart/form-data; boundary=" + boundary
+
+	return &requestBody{
+		contentType: contentType,
+		length:      -1,
+		open: func(ctx context.Context) (io.ReadCloser, error) {
+			// Open every file upfront so errors are reported before sending.
+			readers := make([]io.ReadCloser, 0, len(files))
+			for _, file := range files {
+				reader, err := file.Open()
+				if err != nil {
+					for _, opened := range readers {
+						opened.Close()
+					}
+					return nil, fmt.Errorf("opening %s: %w", file.FileName, err)
+				}
+				readers = append(readers, reader)
+			}
+
+			pr, pw := io.Pipe()
+			go func() {
+				defer func() {
+					for _, reader := range readers {
+						reader.Close()
+					}
+				}()
+				pw.CloseWithError(writeMultipart(ctx, pw, boundary, fields, files, readers))
+			}()
+			return pr, nil
+		},
+	}
+}
+
+func writeMultipart(ctx context.Context, w io.Writer, boundary string, fields []formField, files []File, readers []io.ReadCloser) error {
+	writer := multipart.NewWriter(w)
+	if err := writer.SetBoundary(boundary); err != nil {
+		return err
+	}
+	for _, field := range fields {
+		if err := writer.WriteField(field.Name, field.Value); err != nil {
+			return err
+		}
+	}
+	for i, file := range files {
+		part, err := writer.CreateFormFile(file.FieldName, file.FileName)
+		if err != nil {
+			return err
+		}
+		progress := &progressWriter{ctx: ctx, file: file}
+		if _, err := io.Copy(io.MultiWriter(part, progress), readers[i]); err != nil {
+			return err
+		}
+		progress.done()
+	}
+	return writer.Close()
+}
+
+// progressWriter logs how many bytes of a file have been uploaded.
+type progressWriter struct {
+	ctx      context.Context
+	file     File
+	written  int64
+	reported int64
+}
+
+func (p *progressWriter) Write(b []byte) (int, error) {
+	p.written += int64(len(b))
+	if p.written-p.reported >= uploadProgressInterval {
+		p.reported = p.written
+		p.log("Uploading file")
+	}
+	return len(b), nil
+}
+
+func (p *progressWriter) done() {
+	p.log("Uploaded file")
+}
+
+func (p *progressWriter) log(msg string) {
+	fields := map[string]interface{}{
+		"field":    p.file.FieldName,
+		"file":     p.file.FileName,
+		"uploaded": p.written,
+	}
+	if p.file.Size > 0 {
+		fields["size"] = p.file.Size
+		fields["percent"] = p.written * 100 / p.file.Size
+	}
+	tflog.Debug(p.ctx, msg, fields)
+}
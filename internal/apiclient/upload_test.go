@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apiclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProblemCreateStreamsFile(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if r.FormValue("problem_alias") != "sumas" || r.FormValue("languages") != "cpp17-gcc,py3" {
+			t.Errorf("unexpected form: %v", r.Form)
+		}
+		file, header, err := r.FormFile("problem_contents")
+		if err != nil {
+			t.Fatalf("missing file: %v", err)
+		}
+		defer file.Close()
+		contents, _ := io.ReadAll(file)
+		if header.Filename != "sumas.zip" || string(contents) != "zip contents" {
+			t.Errorf("unexpected file %s: %q", header.Filename, contents)
+		}
+		// The first attempt fails to check the file is sent again.
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "sumas.zip")
+	if err := os.WriteFile(path, []byte("zip contents"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	client := NewClient("token", server.URL)
+	client.Retry = RetryConfig{MaxRetries: 1, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+	err := client.ProblemCreate(context.Background(), &ProblemCreateRequest{
+		ProblemAlias: "sumas",
+		Title:        "Sumas",
+		Languages:    []string{"cpp17-gcc", "py3"},
+	}, FileFromPath("", path))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("expected 2 attempts, got %d", attempts)
+	}
+}
+
+func TestFileFromReaderIsNotReplayed(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := NewClient("token", server.URL)
+	client.Retry = RetryConfig{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: time.Millisecond}
+	// Wrap the reader so it does not implement io.Seeker.
+	reader := io.MultiReader(bytes.NewBufferString("zip contents"))
+	err := client.ProblemCreate(context.Background(), &ProblemCreateRequest{ProblemAlias: "sumas"}, FileFromReader("", "sumas.zip", reader))
+	if err == nil || !strings.Contains(err.Error(), errBodyNotReplayable.Error()) {
+		t.Fatalf("expected a not replayable error, got: %v", err)
+	}
+	if attempts != 1 {
+		t.Errorf("expected a single attempt, got %d", attempts)
+	}
+}
@@ -7,7 +7,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"terraform-provider-omegaup/internal/apiclient"
+	"time"
 )
 
 func groupHandler(state state, payload []byte, w http.ResponseWriter, r *http.Request) {
@@ -18,8 +20,9 @@ func groupHandler(state state, payload []byte, w http.ResponseWriter, r *http.Re
 			return
 		}
 		state.MockGroups[req.Alias] = mockGroup{
-			Group:   (*apiclient.Group)(req),
-			Members: make(map[string]struct{}),
+			Group:      (*apiclient.Group)(req),
+			CreateTime: int(time.Now().Unix()),
+			Members:    make(map[string]struct{}),
 		}
 		w.WriteHeader(http.StatusOK)
 		return
@@ -49,7 +52,8 @@ func groupHandler(state state, payload []byte, w http.ResponseWriter, r *http.Re
 				Group: apiclient.GroupDetailsResponseGroup{
 					Alias:       data.Group.Alias,
 					Description: data.Group.Description,
-					Name:        data.Group.Name},
+					Name:        data.Group.Name,
+					CreateTime:  data.CreateTime},
 			})
 			if err != nil {
 				http.Error(w, "Marshalling response", http.StatusInternalServerError)
@@ -60,7 +64,7 @@ func groupHandler(state state, payload []byte, w http.ResponseWriter, r *http.Re
 			w.WriteHeader(http.StatusOK)
 			return
 		} else {
-			http.Error(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), http.StatusNotFound)
+			writeError(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), "resourceNotFound", http.StatusNotFound)
 			return
 		}
 	}
@@ -77,7 +81,7 @@ func groupHandler(state state, payload []byte, w http.ResponseWriter, r *http.Re
 			w.WriteHeader(http.StatusOK)
 			return
 		} else {
-			http.Error(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), http.StatusNotFound)
+			writeError(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), "resourceNotFound", http.StatusNotFound)
 			return
 		}
 	}
@@ -94,7 +98,7 @@ func groupHandler(state state, payload []byte, w http.ResponseWriter, r *http.Re
 			w.WriteHeader(http.StatusOK)
 			return
 		} else {
-			http.Error(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), http.StatusNotFound)
+			writeError(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), "resourceNotFound", http.StatusNotFound)
 			return
 		}
 	}
@@ -122,9 +126,55 @@ func groupHandler(state state, payload []byte, w http.ResponseWriter, r *http.Re
 			w.WriteHeader(http.StatusOK)
 			return
 		} else {
-			http.Error(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), http.StatusNotFound)
+			writeError(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), "resourceNotFound", http.StatusNotFound)
 			return
 		}
 	}
+	if r.URL.Path == "/api/group/myList" {
+		groups := make([]apiclient.GroupDetailsResponseGroup, 0, len(state.MockGroups))
+		for _, data := range state.MockGroups {
+			groups = append(groups, apiclient.GroupDetailsResponseGroup{
+				Alias:       data.Group.Alias,
+				Description: data.Group.Description,
+				Name:        data.Group.Name,
+				CreateTime:  data.CreateTime,
+			})
+		}
+		res, err := json.Marshal(&apiclient.GroupMyListResponse{
+			Groups: groups,
+		})
+		if err != nil {
+			http.Error(w, "Marshalling response", http.StatusInternalServerError)
+		}
+		if _, err = w.Write(res); err != nil {
+			http.Error(w, "Writing response", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if r.URL.Path == "/api/group/list" {
+		var req *apiclient.GroupListRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		groups := []apiclient.GroupListItem{}
+		for alias, data := range state.MockGroups {
+			if strings.Contains(alias, req.Query) || strings.Contains(data.Group.Name, req.Query) {
+				groups = append(groups, apiclient.GroupListItem{
+					Label: data.Group.Name,
+					Value: alias,
+				})
+			}
+		}
+		res, err := json.Marshal(groups)
+		if err != nil {
+			http.Error(w, "Marshalling response", http.StatusInternalServerError)
+		}
+		if _, err = w.Write(res); err != nil {
+			http.Error(w, "Writing response", http.StatusInternalServerError)
+		}
+		return
+	}
 	http.Error(w, "Not implemented", http.StatusNotImplemented)
 }
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mocks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"terraform-provider-omegaup/internal/apiclient"
+	"time"
+)
+
+func scoreboardKey(groupAlias string, scoreboardAlias string) string {
+	return groupAlias + "/" + scoreboardAlias
+}
+
+func groupScoreboardHandler(state state, payload []byte, w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/groupScoreboard/create" {
+		var req *apiclient.GroupScoreboardCreateRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		if _, exists := state.MockGroups[req.GroupAlias]; !exists {
+			writeError(w, fmt.Sprintf("Group %s does not exists", req.GroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		key := scoreboardKey(req.GroupAlias, req.Alias)
+		if _, exists := state.MockScoreboards[key]; exists {
+			writeError(w, fmt.Sprintf("Scoreboard %s already exists", req.Alias), "aliasInUse", http.StatusBadRequest)
+			return
+		}
+		state.MockScoreboards[key] = mockScoreboard{
+			Scoreboard: (*apiclient.GroupScoreboard)(req),
+			CreateTime: int(time.Now().Unix()),
+			Contests:   []apiclient.GroupScoreboardContest{},
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/groupScoreboard/addContest" {
+		var req struct {
+			GroupAlias      string `json:"group_alias"`
+			ScoreboardAlias string `json:"scoreboard_alias"`
+			ContestAlias    string `json:"contest_alias"`
+			Weight          string `json:"weight"`
+			OnlyAC          string `json:"only_ac"`
+		}
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		key := scoreboardKey(req.GroupAlias, req.ScoreboardAlias)
+		data, exists := state.MockScoreboards[key]
+		if !exists {
+			writeError(w, fmt.Sprintf("Scoreboard %s does not exists", req.ScoreboardAlias), "invalidGroupScoreboard", http.StatusNotFound)
+			return
+		}
+		for _, contest := range data.Contests {
+			if contest.Alias == req.ContestAlias {
+				writeError(w, fmt.Sprintf("Contest %s already in scoreboard", req.ContestAlias), "duplicatedEntry", http.StatusBadRequest)
+				return
+			}
+		}
+		weight, err := strconv.ParseFloat(req.Weight, 64)
+		if err != nil {
+			http.Error(w, "Error decoding weight", http.StatusBadRequest)
+			return
+		}
+		data.Contests = append(data.Contests, apiclient.GroupScoreboardContest{
+			Alias:  req.ContestAlias,
+			Title:  req.ContestAlias,
+			Weight: weight,
+			OnlyAC: req.OnlyAC == "true",
+		})
+		state.MockScoreboards[key] = data
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/groupScoreboard/removeContest" {
+		var req *apiclient.GroupScoreboardRemoveContestRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		key := scoreboardKey(req.GroupAlias, req.ScoreboardAlias)
+		data, exists := state.MockScoreboards[key]
+		if !exists {
+			writeError(w, fmt.Sprintf("Scoreboard %s does not exists", req.ScoreboardAlias), "invalidGroupScoreboard", http.StatusNotFound)
+			return
+		}
+		contests := []apiclient.GroupScoreboardContest{}
+		for _, contest := range data.Contests {
+			if contest.Alias != req.ContestAlias {
+				contests = append(contests, contest)
+			}
+		}
+		data.Contests = contests
+		state.MockScoreboards[key] = data
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/groupScoreboard/details" {
+		var req *apiclient.GroupScoreboardDetailsRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		data, exists := state.MockScoreboards[scoreboardKey(req.GroupAlias, req.ScoreboardAlias)]
+		if !exists {
+			writeError(w, fmt.Sprintf("Scoreboard %s does not exists", req.ScoreboardAlias), "invalidGroupScoreboard", http.StatusNotFound)
+			return
+		}
+		// Every member of the group is ranked, without any submission.
+		ranking := []apiclient.GroupScoreboardRankingEntry{}
+		for username := range state.MockGroups[req.GroupAlias].Members {
+			ranking = append(ranking, apiclient.GroupScoreboardRankingEntry{
+				Username:  username,
+				Name:      username,
+				IsInvited: true,
+				Contests:  apiclient.GroupScoreboardContestScores{},
+			})
+		}
+		sort.Slice(ranking, func(i, j int) bool { return ranking[i].Username < ranking[j].Username })
+		res, err := json.Marshal(&apiclient.GroupScoreboardDetailsResponse{
+			Scoreboard: apiclient.GroupScoreboardDetailsResponseScoreboard{
+				Alias:       data.Scoreboard.Alias,
+				Name:        data.Scoreboard.Name,
+				Description: data.Scoreboard.Description,
+				CreateTime:  data.CreateTime,
+			},
+			Contests: data.Contests,
+			Ranking:  ranking,
+		})
+		if err != nil {
+			http.Error(w, "Marshalling response", http.StatusInternalServerError)
+		}
+		if _, err = w.Write(res); err != nil {
+			http.Error(w, "Writing response", http.StatusInternalServerError)
+		}
+		return
+	}
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mocks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-omegaup/internal/apiclient"
+)
+
+func identityHandler(state state, payload []byte, w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/identity/bulkCreateForTeams" {
+		var req struct {
+			TeamGroupAlias string `json:"team_group_alias"`
+			TeamIdentities string `json:"team_identities"`
+		}
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		var identities []apiclient.TeamIdentity
+		if err := json.Unmarshal([]byte(req.TeamIdentities), &identities); err != nil {
+			http.Error(w, "Error decoding team identities", http.StatusBadRequest)
+			return
+		}
+		data, exists := state.MockTeamsGroups[req.TeamGroupAlias]
+		if !exists {
+			writeError(w, fmt.Sprintf("Teams group %s does not exists", req.TeamGroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		for _, identity := range identities {
+			if _, exists := data.Teams[identity.Username]; exists {
+				writeError(w, fmt.Sprintf("Username %s is already in use", identity.Username), "usernameInUse", http.StatusBadRequest)
+				return
+			}
+		}
+		for _, identity := range identities {
+			data.Teams[identity.Username] = apiclient.GroupIdentity{
+				Username:  identity.Username,
+				Name:      identity.Name,
+				Gender:    identity.Gender,
+				CountryId: identity.CountryId,
+				StateId:   identity.StateId,
+				School:    identity.SchoolName,
+				Password:  identity.Password,
+			}
+			members := make(map[string]struct{})
+			for _, username := range strings.Split(identity.Usernames, ",") {
+				if username != "" {
+					members[username] = struct{}{}
+				}
+			}
+			state.MockTeamMembers[identity.Username] = members
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/identity/updateIdentityTeam" {
+		var req *apiclient.IdentityUpdateIdentityTeamRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		data, exists := state.MockTeamsGroups[req.GroupAlias]
+		if !exists {
+			writeError(w, fmt.Sprintf("Teams group %s does not exists", req.GroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		if _, exists := data.Teams[req.OriginalUsername]; !exists {
+			writeError(w, fmt.Sprintf("Team %s does not exists", req.OriginalUsername), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		password := data.Teams[req.OriginalUsername].Password
+		delete(data.Teams, req.OriginalUsername)
+		data.Teams[req.Username] = apiclient.GroupIdentity{
+			Username:  req.Username,
+			Name:      req.Name,
+			Gender:    req.Gender,
+			CountryId: req.CountryId,
+			StateId:   req.StateId,
+			School:    req.SchoolName,
+			Password:  password,
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/identity/changePassword" {
+		var req *apiclient.IdentityChangePasswordRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		data, exists := state.MockTeamsGroups[req.GroupAlias]
+		if !exists {
+			writeError(w, fmt.Sprintf("Teams group %s does not exists", req.GroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		identity, exists := data.Teams[req.Username]
+		if !exists {
+			writeError(w, fmt.Sprintf("Team %s does not exists", req.Username), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		identity.Password = req.Password
+		data.Teams[req.Username] = identity
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
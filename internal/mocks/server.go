@@ -12,16 +12,49 @@ import (
 )
 
 type mockGroup struct {
-	Group   *apiclient.Group
-	Members map[string]struct{}
+	Group      *apiclient.Group
+	CreateTime int
+	Members    map[string]struct{}
+}
+
+type mockScoreboard struct {
+	Scoreboard *apiclient.GroupScoreboard
+	CreateTime int
+	Contests   []apiclient.GroupScoreboardContest
+}
+
+type mockTeamsGroup struct {
+	TeamsGroup *apiclient.TeamsGroup
+	CreateTime int
+	Teams      map[string]apiclient.GroupIdentity
 }
 
 type state struct {
-	MockGroups map[string]mockGroup
+	MockGroups      map[string]mockGroup
+	MockScoreboards map[string]mockScoreboard
+	MockTeamsGroups map[string]mockTeamsGroup
+	// MockTeamMembers are the members of each team, by team username.
+	MockTeamMembers map[string]map[string]struct{}
+}
+
+// writeError replies with an error body shaped like the ones omegaUp sends.
+func writeError(w http.ResponseWriter, message string, errorname string, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{
+		"status":    "error",
+		"error":     message,
+		"errorname": errorname,
+	})
 }
 
 func NewMockServer() *httptest.Server {
-	state := state{MockGroups: make(map[string]mockGroup)}
+	state := state{
+		MockGroups:      make(map[string]mockGroup),
+		MockScoreboards: make(map[string]mockScoreboard),
+		MockTeamsGroups: make(map[string]mockTeamsGroup),
+		MockTeamMembers: make(map[string]map[string]struct{}),
+	}
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		err := r.ParseMultipartForm(10 << 20)
 		if err != nil {
@@ -46,6 +79,31 @@ func NewMockServer() *httptest.Server {
 			return
 		}
 
+		if strings.HasPrefix(r.URL.Path, "/api/groupScoreboard/") {
+			groupScoreboardHandler(state, payload, w, r)
+			return
+		}
+
+		if strings.HasPrefix(r.URL.Path, "/api/teamsGroup/") {
+			teamsGroupHandler(state, payload, w, r)
+			return
+		}
+
+		if strings.HasPrefix(r.URL.Path, "/api/identity/") {
+			identityHandler(state, payload, w, r)
+			return
+		}
+
+		if strings.HasPrefix(r.URL.Path, "/api/session/") {
+			sessionHandler(w, r)
+			return
+		}
+
+		if strings.HasPrefix(r.URL.Path, "/api/user/") {
+			userHandler(payload, w, r)
+			return
+		}
+
 		http.Error(w, "Not implemented", http.StatusNotImplemented)
 	}))
 }
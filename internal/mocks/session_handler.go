@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"terraform-provider-omegaup/internal/apiclient"
+)
+
+// Username of the identity that owns every valid token of the mock server.
+const mockUsername = "omegaup"
+
+func sessionHandler(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/session/currentSession" {
+		session := apiclient.SessionInfo{Valid: false}
+		_, cookieErr := r.Cookie("ouat")
+		if strings.HasPrefix(r.Header.Get("Authorization"), "token ") || cookieErr == nil {
+			session = apiclient.SessionInfo{
+				Valid: true,
+				Identity: &apiclient.SessionIdentity{
+					Username: mockUsername,
+					Name:     mockUsername,
+				},
+			}
+		}
+		res, err := json.Marshal(&apiclient.SessionCurrentSessionResponse{
+			Session: session,
+		})
+		if err != nil {
+			http.Error(w, "Marshalling response", http.StatusInternalServerError)
+			return
+		}
+		if _, err = w.Write(res); err != nil {
+			http.Error(w, "Writing response", http.StatusInternalServerError)
+		}
+		return
+	}
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
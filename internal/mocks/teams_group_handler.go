@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mocks
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"terraform-provider-omegaup/internal/apiclient"
+	"time"
+)
+
+// decodeTeamsGroup decodes the form of create and update, where the number of
+// contestants arrives as a string.
+func decodeTeamsGroup(payload []byte) (*apiclient.TeamsGroup, error) {
+	var req struct {
+		Alias               string `json:"alias"`
+		Description         string `json:"description"`
+		Name                string `json:"name"`
+		NumberOfContestants string `json:"numberOfContestants"`
+	}
+	if err := json.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+	numberOfContestants := 3
+	if req.NumberOfContestants != "" {
+		var err error
+		if numberOfContestants, err = strconv.Atoi(req.NumberOfContestants); err != nil {
+			return nil, err
+		}
+	}
+	return &apiclient.TeamsGroup{
+		Alias:               req.Alias,
+		Description:         req.Description,
+		Name:                req.Name,
+		NumberOfContestants: numberOfContestants,
+	}, nil
+}
+
+func teamsGroupHandler(state state, payload []byte, w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/teamsGroup/create" {
+		teamsGroup, err := decodeTeamsGroup(payload)
+		if err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		if _, exists := state.MockTeamsGroups[teamsGroup.Alias]; exists {
+			writeError(w, fmt.Sprintf("Teams group %s already exists", teamsGroup.Alias), "aliasInUse", http.StatusBadRequest)
+			return
+		}
+		state.MockTeamsGroups[teamsGroup.Alias] = mockTeamsGroup{
+			TeamsGroup: teamsGroup,
+			CreateTime: int(time.Now().Unix()),
+			Teams:      make(map[string]apiclient.GroupIdentity),
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/teamsGroup/update" {
+		teamsGroup, err := decodeTeamsGroup(payload)
+		if err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		entry, exists := state.MockTeamsGroups[teamsGroup.Alias]
+		if !exists {
+			writeError(w, fmt.Sprintf("Teams group %s does not exists", teamsGroup.Alias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		entry.TeamsGroup = teamsGroup
+		state.MockTeamsGroups[teamsGroup.Alias] = entry
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/teamsGroup/details" {
+		var req *apiclient.TeamsGroupDetailsRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		data, exists := state.MockTeamsGroups[req.TeamGroupAlias]
+		if !exists {
+			writeError(w, fmt.Sprintf("Teams group %s does not exists", req.TeamGroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		res, err := json.Marshal(&apiclient.TeamsGroupDetailsResponse{
+			TeamGroup: apiclient.TeamsGroupDetailsResponseTeamGroup{
+				Alias:               data.TeamsGroup.Alias,
+				Description:         data.TeamsGroup.Description,
+				Name:                data.TeamsGroup.Name,
+				NumberOfContestants: data.TeamsGroup.NumberOfContestants,
+				CreateTime:          data.CreateTime,
+			},
+		})
+		if err != nil {
+			http.Error(w, "Marshalling response", http.StatusInternalServerError)
+		}
+		if _, err = w.Write(res); err != nil {
+			http.Error(w, "Writing response", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if r.URL.Path == "/api/teamsGroup/teams" {
+		var req *apiclient.TeamsGroupTeamsRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		data, exists := state.MockTeamsGroups[req.TeamGroupAlias]
+		if !exists {
+			writeError(w, fmt.Sprintf("Teams group %s does not exists", req.TeamGroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		identities := make([]apiclient.GroupIdentity, 0, len(data.Teams))
+		for _, identity := range data.Teams {
+			identities = append(identities, identity)
+		}
+		res, err := json.Marshal(&apiclient.TeamsGroupTeamsResponse{
+			Identities: identities,
+		})
+		if err != nil {
+			http.Error(w, "Marshalling response", http.StatusInternalServerError)
+		}
+		if _, err = w.Write(res); err != nil {
+			http.Error(w, "Writing response", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	if r.URL.Path == "/api/teamsGroup/removeTeam" {
+		var req *apiclient.TeamsGroupRemoveTeamRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		data, exists := state.MockTeamsGroups[req.TeamGroupAlias]
+		if !exists {
+			writeError(w, fmt.Sprintf("Teams group %s does not exists", req.TeamGroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		delete(data.Teams, req.UsernameOrEmail)
+		delete(state.MockTeamMembers, req.UsernameOrEmail)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/teamsGroup/addMembers" {
+		var req struct {
+			TeamGroupAlias string `json:"team_group_alias"`
+			Usernames      string `json:"usernames"`
+		}
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		members, exists := state.MockTeamMembers[req.TeamGroupAlias]
+		if !exists {
+			members = make(map[string]struct{})
+			state.MockTeamMembers[req.TeamGroupAlias] = members
+		}
+		for _, username := range strings.Split(req.Usernames, ",") {
+			if _, exists := members[username]; exists {
+				writeError(w, fmt.Sprintf("User %s is already a member", username), "teamMemberExists", http.StatusBadRequest)
+				return
+			}
+			members[username] = struct{}{}
+		}
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/teamsGroup/removeMember" {
+		var req *apiclient.TeamsGroupRemoveMemberRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		members, exists := state.MockTeamMembers[req.TeamGroupAlias]
+		if !exists {
+			writeError(w, fmt.Sprintf("Team %s does not exists", req.TeamGroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		delete(members, req.Username)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	if r.URL.Path == "/api/teamsGroup/teamsMembers" {
+		var req struct {
+			TeamGroupAlias string `json:"team_group_alias"`
+		}
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		if _, exists := state.MockTeamsGroups[req.TeamGroupAlias]; !exists {
+			writeError(w, fmt.Sprintf("Teams group %s does not exists", req.TeamGroupAlias), "resourceNotFound", http.StatusNotFound)
+			return
+		}
+		// Team usernames are prefixed with the alias of their teams group.
+		users := []apiclient.TeamMember{}
+		for team, members := range state.MockTeamMembers {
+			if !strings.HasPrefix(team, "teams:"+req.TeamGroupAlias+":") {
+				continue
+			}
+			for username := range members {
+				users = append(users, apiclient.TeamMember{
+					Username:  username,
+					Name:      username,
+					TeamAlias: team,
+					TeamName:  team,
+				})
+			}
+		}
+		res, err := json.Marshal(&apiclient.TeamsGroupTeamsMembersResponse{
+			PageNumber: 1,
+			TeamsUsers: users,
+			TotalRows:  len(users),
+		})
+		if err != nil {
+			http.Error(w, "Marshalling response", http.StatusInternalServerError)
+		}
+		if _, err = w.Write(res); err != nil {
+			http.Error(w, "Writing response", http.StatusInternalServerError)
+		}
+		return
+	}
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
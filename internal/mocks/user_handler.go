@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mocks
+
+import (
+	"encoding/json"
+	"net/http"
+	"terraform-provider-omegaup/internal/apiclient"
+)
+
+func userHandler(payload []byte, w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/api/user/login" {
+		var req *apiclient.UserLoginRequest
+		if err := json.Unmarshal(payload, &req); err != nil {
+			http.Error(w, "Error decoding form data to JSON", http.StatusBadRequest)
+			return
+		}
+		if req.UsernameOrEmail == "" || req.Password == "" {
+			writeError(w, "Username or password is wrong", "usernameOrPassIsWrong", http.StatusForbidden)
+			return
+		}
+		res, err := json.Marshal(&apiclient.UserLoginResponse{
+			AuthToken: "auth_token_" + req.UsernameOrEmail,
+		})
+		if err != nil {
+			http.Error(w, "Marshalling response", http.StatusInternalServerError)
+			return
+		}
+		if _, err = w.Write(res); err != nil {
+			http.Error(w, "Writing response", http.StatusInternalServerError)
+		}
+		return
+	}
+	http.Error(w, "Not implemented", http.StatusNotImplemented)
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupDataSource{}
+
+func NewGroupDataSource() datasource.DataSource {
+	return &GroupDataSource{}
+}
+
+// GroupDataSource defines the data source implementation.
+type GroupDataSource struct {
+	client *apiclient.Client
+}
+
+// GroupDataSourceModel describes the data source data model.
+type GroupDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Alias       types.String `tfsdk:"alias"`
+	Description types.String `tfsdk:"description"`
+	Name        types.String `tfsdk:"name"`
+	CreateTime  types.String `tfsdk:"create_time"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+}
+
+func (d *GroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+func (d *GroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Looks up an existing group by its alias.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the group, same as the alias.",
+				Computed:            true,
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "Unique short title used to identify the group.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the group.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Friendly name of the group.",
+				Computed:            true,
+			},
+			"create_time": schema.StringAttribute{
+				MarkdownDescription: "Time the group was created, in RFC3339 format.",
+				Computed:            true,
+			},
+			"member_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of members of the group.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *GroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = providerData.Client
+}
+
+func (d *GroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := d.client.GroupDetails(ctx, &apiclient.GroupDetailsRequest{
+		GroupAlias: data.Alias.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Group",
+			fmt.Sprintf("An unexpected error occurred while attempting to read the group %q.\n\n", data.Alias.ValueString())+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	members, err := d.client.GroupMembers(ctx, &apiclient.GroupMembersRequest{
+		GroupAlias: data.Alias.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Group",
+			fmt.Sprintf("An unexpected error occurred while attempting to read the members of the group %q.\n\n", data.Alias.ValueString())+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model.
+	data.ID = types.StringValue(group.Group.Alias)
+	data.Alias = types.StringValue(group.Group.Alias)
+	data.Description = types.StringValue(group.Group.Description)
+	data.Name = types.StringValue(group.Group.Name)
+	data.CreateTime = unixTimeValue(group.Group.CreateTime)
+	data.MemberCount = types.Int64Value(int64(len(members.Identities)))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,62 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupDataSource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.omegaup_group.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("Admins"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.omegaup_group.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("description"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.omegaup_group.test",
+						tfjsonpath.New("member_count"),
+						knownvalue.Int64Exact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccGroupDataSourceConfig = `
+resource "omegaup_group" "group" {
+  alias       = "admins"
+  name        = "Admins"
+  description = "description"
+}
+resource "omegaup_group_member" "member" {
+  group_alias = omegaup_group.group.alias
+  username    = "test"
+}
+data "omegaup_group" "test" {
+  alias = omegaup_group_member.member.group_alias
+}
+`
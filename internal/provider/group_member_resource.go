@@ -68,16 +68,16 @@ func (r *GroupMemberResource) Configure(ctx context.Context, req resource.Config
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*apiclient.Client)
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *apiclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *GroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -95,7 +95,7 @@ func (r *GroupMemberResource) Create(ctx context.Context, req resource.CreateReq
 		UsernameOrEmail: data.Username.ValueString(),
 	}
 
-	err := r.client.GroupAddUser(addReq)
+	err := r.client.GroupAddUser(ctx, addReq)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -127,10 +127,16 @@ func (r *GroupMemberResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
-	members, err := r.client.GroupMembers(&apiclient.GroupMembersRequest{
+	members, err := r.client.GroupMembers(ctx, &apiclient.GroupMembersRequest{
 		GroupAlias: data.GroupAlias.ValueString(),
 	})
 
+	if apiclient.IsNotFound(err) {
+		// The group no longer exists, so neither does the membership.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Refresh Resource",
@@ -177,7 +183,7 @@ func (r *GroupMemberResource) Delete(ctx context.Context, req resource.DeleteReq
 		UsernameOrEmail: data.Username.ValueString(),
 	}
 
-	err := r.client.GroupRemoveUser(deleteReq)
+	err := r.client.GroupRemoveUser(ctx, deleteReq)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
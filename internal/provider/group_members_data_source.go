@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupMembersDataSource{}
+
+func NewGroupMembersDataSource() datasource.DataSource {
+	return &GroupMembersDataSource{}
+}
+
+// GroupMembersDataSource defines the data source implementation.
+type GroupMembersDataSource struct {
+	client *apiclient.Client
+}
+
+// GroupMembersDataSourceModel describes the data source data model.
+type GroupMembersDataSourceModel struct {
+	GroupAlias     types.String                   `tfsdk:"group_alias"`
+	UsernamePrefix types.String                   `tfsdk:"username_prefix"`
+	Members        []GroupMembersDataSourceMember `tfsdk:"members"`
+}
+
+// GroupMembersDataSourceMember describes each of the members of the group.
+type GroupMembersDataSourceMember struct {
+	Username  types.String `tfsdk:"username"`
+	Name      types.String `tfsdk:"name"`
+	Gender    types.String `tfsdk:"gender"`
+	CountryId types.String `tfsdk:"country_id"`
+	Country   types.String `tfsdk:"country"`
+	StateId   types.String `tfsdk:"state_id"`
+	State     types.String `tfsdk:"state"`
+	SchoolId  types.Int64  `tfsdk:"school_id"`
+	School    types.String `tfsdk:"school"`
+}
+
+func (d *GroupMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+func (d *GroupMembersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the members of a group with their identity details.",
+
+		Attributes: map[string]schema.Attribute{
+			"group_alias": schema.StringAttribute{
+				MarkdownDescription: "The alias used to identify the group.",
+				Required:            true,
+			},
+			"username_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return the members whose username starts with this prefix, ignoring case.",
+				Optional:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "Members of the group, sorted by username.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"gender": schema.StringAttribute{
+							Computed: true,
+						},
+						"country_id": schema.StringAttribute{
+							MarkdownDescription: "Country id based on ISO 3166-2",
+							Computed:            true,
+						},
+						"country": schema.StringAttribute{
+							MarkdownDescription: "Name of the country.",
+							Computed:            true,
+						},
+						"state_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the state.",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "Name of the state.",
+							Computed:            true,
+						},
+						"school_id": schema.Int64Attribute{
+							MarkdownDescription: "Id of the school.",
+							Computed:            true,
+						},
+						"school": schema.StringAttribute{
+							MarkdownDescription: "Name of the school.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = providerData.Client
+}
+
+func (d *GroupMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupMembersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := d.client.GroupMembers(ctx, &apiclient.GroupMembersRequest{
+		GroupAlias: data.GroupAlias.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Group Members",
+			fmt.Sprintf("An unexpected error occurred while attempting to read the members of the group %q.\n\n", data.GroupAlias.ValueString())+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	prefix := strings.ToLower(data.UsernamePrefix.ValueString())
+	identities := []apiclient.GroupIdentity{}
+	for _, identity := range members.Identities {
+		if strings.HasPrefix(strings.ToLower(identity.Username), prefix) {
+			identities = append(identities, identity)
+		}
+	}
+	sort.Slice(identities, func(i, j int) bool { return identities[i].Username < identities[j].Username })
+
+	// Convert from the API data model to the Terraform data model.
+	data.Members = []GroupMembersDataSourceMember{}
+	for _, identity := range identities {
+		data.Members = append(data.Members, GroupMembersDataSourceMember{
+			Username:  types.StringValue(identity.Username),
+			Name:      types.StringValue(identity.Name),
+			Gender:    types.StringValue(identity.Gender),
+			CountryId: types.StringValue(identity.CountryId),
+			Country:   types.StringValue(identity.Country),
+			StateId:   types.StringValue(identity.StateId),
+			State:     types.StringValue(identity.State),
+			SchoolId:  types.Int64Value(int64(identity.SchoolId)),
+			School:    types.StringValue(identity.School),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
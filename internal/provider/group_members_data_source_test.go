@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupMembersDataSource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupMembersDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.omegaup_group_members.test",
+						tfjsonpath.New("members"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"username": knownvalue.StringExact("admins:alice"),
+							}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"username": knownvalue.StringExact("admins:bob"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccGroupMembersDataSourceConfig = `
+resource "omegaup_group" "group" {
+  alias       = "admins"
+  description = "description"
+}
+resource "omegaup_group_member" "member" {
+  for_each    = toset(["admins:bob", "admins:alice", "carol"])
+  group_alias = omegaup_group.group.alias
+  username    = each.key
+}
+data "omegaup_group_members" "test" {
+  group_alias     = omegaup_group.group.alias
+  username_prefix = "admins:"
+  depends_on      = [omegaup_group_member.member]
+}
+`
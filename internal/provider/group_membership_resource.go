@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupMembershipResource{}
+var _ resource.ResourceWithImportState = &GroupMembershipResource{}
+
+func NewGroupMembershipResource() resource.Resource {
+	return &GroupMembershipResource{}
+}
+
+// GroupMembershipResource defines the resource implementation.
+type GroupMembershipResource struct {
+	client *apiclient.Client
+}
+
+// GroupMembershipResourceModel describes the resource data model.
+type GroupMembershipResourceModel struct {
+	GroupAlias     types.String   `tfsdk:"group_alias"`
+	Usernames      []types.String `tfsdk:"usernames"`
+	IgnorePatterns []types.String `tfsdk:"ignore_patterns"`
+}
+
+func (r *GroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+func (r *GroupMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authoritatively manages the members of a group: members not listed are removed. " +
+			"It does not fit well with the group member, identity and identities resources on the same group.",
+
+		Attributes: map[string]schema.Attribute{
+			"group_alias": schema.StringAttribute{
+				MarkdownDescription: "The alias used to identify the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"usernames": schema.SetAttribute{
+				MarkdownDescription: "OmegaUp usernames of every member of the group.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+			"ignore_patterns": schema.ListAttribute{
+				MarkdownDescription: "Regular expressions of members that are kept even if they are not listed in `usernames`, e.g. members managed by hand. Listed usernames are always added, even if they match a pattern.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *GroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = providerData.Client
+}
+
+func (r *GroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ignorePatterns := compileIgnorePatterns(data.IgnorePatterns, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, data, ignorePatterns); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ignorePatterns := compileIgnorePatterns(data.IgnorePatterns, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := r.client.GroupMembers(ctx, &apiclient.GroupMembersRequest{
+		GroupAlias: data.GroupAlias.ValueString(),
+	})
+
+	if apiclient.IsNotFound(err) {
+		// The group no longer exists, so neither does the membership.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Every member is reported, so extra members show up as drift, except
+	// for the ignored ones that are not managed by this resource.
+	usernames := []types.String{}
+	for _, identity := range members.Identities {
+		username, managed := findUsername(data.Usernames, identity.Username)
+		if managed {
+			usernames = append(usernames, username)
+		} else if !matchesAny(ignorePatterns, identity.Username) {
+			usernames = append(usernames, types.StringValue(identity.Username))
+		}
+	}
+	data.Usernames = usernames
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GroupMembershipResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ignorePatterns := compileIgnorePatterns(data.IgnorePatterns, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, data, ignorePatterns); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GroupMembershipResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the managed members are removed.
+	for _, username := range data.Usernames {
+		err := r.client.GroupRemoveUser(ctx, &apiclient.GroupRemoveUserRequest{
+			GroupAlias:      data.GroupAlias.ValueString(),
+			UsernameOrEmail: username.ValueString(),
+		})
+		if err != nil && !apiclient.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *GroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Usernames are filled by the subsequent Read.
+	var data GroupMembershipResourceModel
+	data.GroupAlias = types.StringValue(req.ID)
+	data.Usernames = []types.String{}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reconcile adds the missing usernames to the group and removes the members
+// that are neither listed nor ignored.
+func (r *GroupMembershipResource) reconcile(ctx context.Context, data GroupMembershipResourceModel, ignorePatterns []*regexp.Regexp) error {
+	groupAlias := data.GroupAlias.ValueString()
+	members, err := r.client.GroupMembers(ctx, &apiclient.GroupMembersRequest{
+		GroupAlias: groupAlias,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, identity := range members.Identities {
+		if _, managed := findUsername(data.Usernames, identity.Username); managed || matchesAny(ignorePatterns, identity.Username) {
+			continue
+		}
+		err := r.client.GroupRemoveUser(ctx, &apiclient.GroupRemoveUserRequest{
+			GroupAlias:      groupAlias,
+			UsernameOrEmail: identity.Username,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, username := range data.Usernames {
+		if isGroupMember(members.Identities, username.ValueString()) {
+			continue
+		}
+		err := r.client.GroupAddUser(ctx, &apiclient.GroupAddUserRequest{
+			GroupAlias:      groupAlias,
+			UsernameOrEmail: username.ValueString(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findUsername looks for a username ignoring case, and returns it with the
+// casing used in the configuration.
+func findUsername(usernames []types.String, username string) (types.String, bool) {
+	for _, candidate := range usernames {
+		if apiclient.EqualUsername(candidate.ValueString(), username) {
+			return candidate, true
+		}
+	}
+	return types.StringNull(), false
+}
+
+func isGroupMember(identities []apiclient.GroupIdentity, username string) bool {
+	for _, identity := range identities {
+		if apiclient.EqualUsername(identity.Username, username) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileIgnorePatterns(patterns []types.String, diags *diag.Diagnostics) []*regexp.Regexp {
+	compiled := []*regexp.Regexp{}
+	for i, pattern := range patterns {
+		re, err := regexp.Compile(pattern.ValueString())
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ignore_patterns").AtListIndex(i),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Unable to compile %q: %s", pattern.ValueString(), err.Error()),
+			)
+			continue
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled
+}
+
+func matchesAny(patterns []*regexp.Regexp, username string) bool {
+	for _, pattern := range patterns {
+		if pattern.MatchString(username) {
+			return true
+		}
+	}
+	return false
+}
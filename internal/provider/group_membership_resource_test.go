@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupMembershipResource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupMembershipResourceConfig("admins", []string{"test", "other"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_group_membership.members",
+						tfjsonpath.New("group_alias"),
+						knownvalue.StringExact("admins"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_group_membership.members",
+						tfjsonpath.New("usernames"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("test"),
+							knownvalue.StringExact("other"),
+						}),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "omegaup_group_membership.members",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "admins",
+				ImportStateVerifyIdentifierAttribute: "group_alias",
+			},
+			// Update and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupMembershipResourceConfig("admins", []string{"other", "new"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_group_membership.members",
+						tfjsonpath.New("usernames"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("other"),
+							knownvalue.StringExact("new"),
+						}),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGroupMembershipResourceIgnorePatterns(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider_config(mockServer.URL) + `
+resource "omegaup_group" "group" {
+  alias = "admins"
+  description = "description"
+}
+resource "omegaup_group_member" "manual" {
+  group_alias = omegaup_group.group.alias
+  username = "admin_manual"
+}
+resource "omegaup_group_membership" "members" {
+  group_alias = omegaup_group.group.alias
+  usernames = ["test"]
+  ignore_patterns = ["^admin_"]
+  depends_on = [omegaup_group_member.manual]
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_group_membership.members",
+						tfjsonpath.New("usernames"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("test"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestAccGroupMembershipResourceDrift(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	config := provider_config(mockServer.URL) + `
+resource "omegaup_group" "group" {
+  alias = "admins"
+  description = "description"
+}
+resource "omegaup_group_membership" "members" {
+  group_alias = omegaup_group.group.alias
+  usernames = ["test"]
+  ignore_patterns = ["^admin_"]
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+			},
+			// A member added outside of Terraform is drift, and is removed.
+			{
+				PreConfig: testAccGroupAddMember(t, mockServer.URL, "admins", "intruder"),
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("omegaup_group_membership.members", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: testAccCheckGroupMemberCount(mockServer.URL, "admins", 1),
+			},
+			// Members matching ignore_patterns are not drift.
+			{
+				PreConfig: testAccGroupAddMember(t, mockServer.URL, "admins", "admin_manual"),
+				Config:    config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: testAccCheckGroupMemberCount(mockServer.URL, "admins", 2),
+			},
+		},
+	})
+}
+
+func testAccGroupMembershipResourceConfig(alias string, usernames []string) string {
+	return fmt.Sprintf(`
+resource "omegaup_group" "group" {
+  alias = %[1]q
+  description = "description"
+}
+resource "omegaup_group_membership" "members" {
+  group_alias = omegaup_group.group.alias
+  usernames = ["%[2]s"]
+}
+`, alias, strings.Join(usernames, `", "`))
+}
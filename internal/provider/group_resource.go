@@ -7,14 +7,26 @@ import (
 	"context"
 	"fmt"
 	"terraform-provider-omegaup/internal/apiclient"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// Values of on_destroy. OmegaUp has no way to delete a group, so the closest
+// is leaving it empty.
+const (
+	groupOnDestroyAbandon = "abandon"
+	groupOnDestroyEmpty   = "empty"
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &GroupResource{}
 var _ resource.ResourceWithImportState = &GroupResource{}
@@ -30,9 +42,14 @@ type GroupResource struct {
 
 // GroupResourceModel describes the resource data model.
 type GroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
 	Alias       types.String `tfsdk:"alias"`
 	Description types.String `tfsdk:"description"`
 	Name        types.String `tfsdk:"name"`
+	CreateTime  types.String `tfsdk:"create_time"`
+
+	OnDestroy          types.String `tfsdk:"on_destroy"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 }
 
 func (r *GroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,6 +62,13 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		MarkdownDescription: "Creates a group.",
 
 		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the group, same as the alias.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"alias": schema.StringAttribute{
 				MarkdownDescription: "Unique short title used to identify the group.",
 				Required:            true,
@@ -64,6 +88,29 @@ func (r *GroupResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"create_time": schema.StringAttribute{
+				MarkdownDescription: "Time the group was created, in RFC3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What to do with the group on destroy, since OmegaUp does not support deleting groups. " +
+					"`abandon` leaves the group untouched, `empty` removes all its members. Defaults to `abandon`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(groupOnDestroyAbandon),
+				Validators: []validator.String{
+					stringvalidator.OneOf(groupOnDestroyAbandon, groupOnDestroyEmpty),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevents the group from being destroyed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -73,16 +120,16 @@ func (r *GroupResource) Configure(ctx context.Context, req resource.ConfigureReq
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*apiclient.Client)
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *apiclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -105,7 +152,7 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 		group.Name = group.Alias
 	}
 
-	err := r.client.GroupCreate(group)
+	err := r.client.GroupCreate(ctx, group)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -120,9 +167,29 @@ func (r *GroupResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	// Convert from the API data model to the Terraform data model
 	// and set any unknown attribute values.
+	data.ID = types.StringValue(group.Alias)
 	data.Alias = types.StringValue(group.Alias)
 	data.Description = types.StringValue(group.Description)
 	data.Name = types.StringValue(group.Name)
+	data.CreateTime = types.StringNull()
+
+	// Read back the attributes computed by OmegaUp. OmegaUp cannot delete
+	// the group, so it is kept in state even if this fails, and create_time
+	// is filled by the next refresh.
+	details, err := r.client.GroupDetails(ctx, &apiclient.GroupDetailsRequest{
+		GroupAlias: group.Alias,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Back Resource",
+			"The group was created but an unexpected error occurred while attempting to read it back, "+
+				"so create_time is unknown until the next refresh.\n\n"+
+				"Error: "+err.Error(),
+		)
+	} else {
+		data.CreateTime = unixTimeValue(details.Group.CreateTime)
+	}
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -138,10 +205,16 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	group, err := r.client.GroupDetails(&apiclient.GroupDetailsRequest{
+	group, err := r.client.GroupDetails(ctx, &apiclient.GroupDetailsRequest{
 		GroupAlias: data.Alias.ValueString(),
 	})
 
+	if apiclient.IsNotFound(err) {
+		// The group no longer exists, let Terraform recreate it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Refresh Resource",
@@ -153,10 +226,13 @@ func (r *GroupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Convert from the API data model to the Terraform data model
-	// and set any unknown attribute values.
-	data.Alias = types.StringValue(group.Group.Alias)
+	// and set any unknown attribute values. OmegaUp cannot rename a group,
+	// but it may return the alias with a different case, so the configured
+	// alias is kept.
+	data.ID = data.Alias
 	data.Description = types.StringValue(group.Group.Description)
 	data.Name = types.StringValue(group.Group.Name)
+	data.CreateTime = unixTimeValue(group.Group.CreateTime)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -194,7 +270,7 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 		group.Name = group.Alias
 	}
 
-	err := r.client.GroupUpdate(group)
+	err := r.client.GroupUpdate(ctx, group)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -209,9 +285,11 @@ func (r *GroupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	// Convert from the API data model to the Terraform data model
 	// and set any unknown attribute values.
+	data.ID = oldData.ID
 	data.Alias = types.StringValue(group.Alias)
 	data.Description = types.StringValue(group.Description)
 	data.Name = types.StringValue(group.Name)
+	data.CreateTime = oldData.CreateTime
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -227,17 +305,58 @@ func (r *GroupResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// If applicable, this is a great opportunity to initialize any necessary
-	// provider client data and make a call using it.
-	// httpResp, err := r.client.Do(httpReq)
-	// if err != nil {
-	//     resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete example, got error: %s", err))
-	//     return
-	// }
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			fmt.Sprintf("The group %q has deletion_protection enabled. "+
+				"Set deletion_protection to false and apply before destroying it.", data.Alias.ValueString()),
+		)
+		return
+	}
+
+	if data.OnDestroy.ValueString() != groupOnDestroyEmpty {
+		// The group is abandoned, it will only be removed from state.
+		return
+	}
+
+	members, err := r.client.GroupMembers(ctx, &apiclient.GroupMembersRequest{
+		GroupAlias: data.Alias.ValueString(),
+	})
+
+	if apiclient.IsNotFound(err) {
+		// Nothing left to clean up.
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Delete Resource",
+			"An unexpected error occurred while attempting to delete the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	for _, identity := range members.Identities {
+		err := r.client.GroupRemoveUser(ctx, &apiclient.GroupRemoveUserRequest{
+			GroupAlias:      data.Alias.ValueString(),
+			UsernameOrEmail: identity.Username,
+		})
+		if err != nil && !apiclient.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+	}
 }
 
 func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	group, err := r.client.GroupDetails(&apiclient.GroupDetailsRequest{
+	group, err := r.client.GroupDetails(ctx, &apiclient.GroupDetailsRequest{
 		GroupAlias: req.ID,
 	})
 
@@ -254,10 +373,23 @@ func (r *GroupResource) ImportState(ctx context.Context, req resource.ImportStat
 	// Convert from the API data model to the Terraform data model
 	// and set any unknown attribute values.
 	var data GroupResourceModel
+	data.ID = types.StringValue(group.Group.Alias)
 	data.Alias = types.StringValue(group.Group.Alias)
 	data.Description = types.StringValue(group.Group.Description)
 	data.Name = types.StringValue(group.Group.Name)
+	data.CreateTime = unixTimeValue(group.Group.CreateTime)
+	data.OnDestroy = types.StringValue(groupOnDestroyAbandon)
+	data.DeletionProtection = types.BoolValue(false)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// unixTimeValue converts a timestamp returned by OmegaUp into an RFC3339
+// string, or null if OmegaUp did not return it.
+func unixTimeValue(timestamp int) types.String {
+	if timestamp == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(time.Unix(int64(timestamp), 0).UTC().Format(time.RFC3339))
+}
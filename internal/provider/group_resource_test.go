@@ -4,13 +4,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"regexp"
+	"terraform-provider-omegaup/internal/apiclient"
 	"terraform-provider-omegaup/internal/mocks"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
 
@@ -41,6 +45,26 @@ func TestAccGroupResource(t *testing.T) {
 						tfjsonpath.New("name"),
 						knownvalue.StringExact("admins"),
 					),
+					statecheck.ExpectKnownValue(
+						"omegaup_group.test",
+						tfjsonpath.New("on_destroy"),
+						knownvalue.StringExact("abandon"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_group.test",
+						tfjsonpath.New("deletion_protection"),
+						knownvalue.Bool(false),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_group.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("admins"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_group.test",
+						tfjsonpath.New("create_time"),
+						knownvalue.NotNull(),
+					),
 				},
 			},
 			// ImportState testing
@@ -78,6 +102,114 @@ func TestAccGroupResource(t *testing.T) {
 	})
 }
 
+func TestAccGroupResourceOnDestroyEmpty(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	config := provider_config(mockServer.URL) + `
+resource "omegaup_group" "test" {
+  alias       = "admins"
+  description = "description"
+  on_destroy  = "empty"
+}
+resource "omegaup_group_member" "member" {
+  group_alias = omegaup_group.test.alias
+  username    = "test"
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The member added outside of Terraform must be removed as well.
+		CheckDestroy: testAccCheckGroupMemberCount(mockServer.URL, "admins", 0),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_group.test",
+						tfjsonpath.New("on_destroy"),
+						knownvalue.StringExact("empty"),
+					),
+				},
+			},
+			{
+				PreConfig: testAccGroupAddMember(t, mockServer.URL, "admins", "outsider"),
+				Config:    config,
+				Check:     testAccCheckGroupMemberCount(mockServer.URL, "admins", 2),
+			},
+		},
+	})
+}
+
+func TestAccGroupResourceDeletionProtection(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupResourceProtectedConfig(true),
+			},
+			{
+				Config:      provider_config(mockServer.URL) + testAccGroupResourceProtectedConfig(true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Unable to Delete Resource"),
+			},
+			// Lift the protection so the group can be destroyed.
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupResourceProtectedConfig(false),
+			},
+		},
+	})
+}
+
+// testAccGroupAddMember adds a member to a group of the mock server, outside
+// of the Terraform graph.
+func testAccGroupAddMember(t *testing.T, url string, alias string, username string) func() {
+	return func() {
+		client := apiclient.NewClient("api_key", url)
+		err := client.GroupAddUser(context.Background(), &apiclient.GroupAddUserRequest{
+			GroupAlias:      alias,
+			UsernameOrEmail: username,
+		})
+		if err != nil {
+			t.Fatalf("unable to add %s to %s: %v", username, alias, err)
+		}
+	}
+}
+
+// testAccCheckGroupMemberCount checks the number of members of a group of the
+// mock server.
+func testAccCheckGroupMemberCount(url string, alias string, expected int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		client := apiclient.NewClient("api_key", url)
+		members, err := client.GroupMembers(context.Background(), &apiclient.GroupMembersRequest{
+			GroupAlias: alias,
+		})
+		if err != nil {
+			return err
+		}
+		if len(members.Identities) != expected {
+			return fmt.Errorf("expected %d members in %s, got %d", expected, alias, len(members.Identities))
+		}
+		return nil
+	}
+}
+
+func testAccGroupResourceProtectedConfig(deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "omegaup_group" "test" {
+  alias               = "admins"
+  description         = "description"
+  deletion_protection = %[1]t
+}
+`, deletionProtection)
+}
+
 func testAccGroupResourceConfig(alias string, description string) string {
 	return fmt.Sprintf(`
 resource "omegaup_group" "test" {
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupScoreboardDataSource{}
+
+func NewGroupScoreboardDataSource() datasource.DataSource {
+	return &GroupScoreboardDataSource{}
+}
+
+// GroupScoreboardDataSource defines the data source implementation.
+type GroupScoreboardDataSource struct {
+	client *apiclient.Client
+}
+
+// GroupScoreboardDataSourceModel describes the data source data model.
+type GroupScoreboardDataSourceModel struct {
+	GroupAlias  types.String                       `tfsdk:"group_alias"`
+	Alias       types.String                       `tfsdk:"alias"`
+	Name        types.String                       `tfsdk:"name"`
+	Description types.String                       `tfsdk:"description"`
+	CreateTime  types.String                       `tfsdk:"create_time"`
+	Contests    []GroupScoreboardDataSourceContest `tfsdk:"contests"`
+	Ranking     []GroupScoreboardDataSourceRank    `tfsdk:"ranking"`
+}
+
+// GroupScoreboardDataSourceContest describes each of the contests of the
+// scoreboard.
+type GroupScoreboardDataSourceContest struct {
+	Alias  types.String  `tfsdk:"alias"`
+	Title  types.String  `tfsdk:"title"`
+	Weight types.Float64 `tfsdk:"weight"`
+	OnlyAC types.Bool    `tfsdk:"only_ac"`
+}
+
+// GroupScoreboardDataSourceRank describes the results of each user.
+type GroupScoreboardDataSourceRank struct {
+	Username     types.String                     `tfsdk:"username"`
+	Name         types.String                     `tfsdk:"name"`
+	TotalPoints  types.Float64                    `tfsdk:"total_points"`
+	TotalPenalty types.Float64                    `tfsdk:"total_penalty"`
+	Contests     []GroupScoreboardDataSourceScore `tfsdk:"contests"`
+}
+
+// GroupScoreboardDataSourceScore describes the result of a user in a contest.
+type GroupScoreboardDataSourceScore struct {
+	Alias   types.String  `tfsdk:"alias"`
+	Points  types.Float64 `tfsdk:"points"`
+	Penalty types.Float64 `tfsdk:"penalty"`
+}
+
+func (d *GroupScoreboardDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_scoreboard"
+}
+
+func (d *GroupScoreboardDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reads a group scoreboard with the aggregated ranking of its contests.",
+
+		Attributes: map[string]schema.Attribute{
+			"group_alias": schema.StringAttribute{
+				MarkdownDescription: "The alias used to identify the group.",
+				Required:            true,
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "Unique short title used to identify the scoreboard within the group.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Friendly name of the scoreboard.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the scoreboard.",
+				Computed:            true,
+			},
+			"create_time": schema.StringAttribute{
+				MarkdownDescription: "Time the scoreboard was created, in RFC3339 format.",
+				Computed:            true,
+			},
+			"contests": schema.ListNestedAttribute{
+				MarkdownDescription: "Contests included in the scoreboard.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"alias": schema.StringAttribute{
+							Computed: true,
+						},
+						"title": schema.StringAttribute{
+							Computed: true,
+						},
+						"weight": schema.Float64Attribute{
+							MarkdownDescription: "Weight of the contest points in the scoreboard.",
+							Computed:            true,
+						},
+						"only_ac": schema.BoolAttribute{
+							MarkdownDescription: "Whether only fully accepted submissions count.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"ranking": schema.ListNestedAttribute{
+				MarkdownDescription: "Results of each user, in the order of the ranking.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"total_points": schema.Float64Attribute{
+							MarkdownDescription: "Weighted points across all the contests.",
+							Computed:            true,
+						},
+						"total_penalty": schema.Float64Attribute{
+							MarkdownDescription: "Penalty across all the contests.",
+							Computed:            true,
+						},
+						"contests": schema.ListNestedAttribute{
+							MarkdownDescription: "Results of the user in each contest, in the same order as `contests`.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"alias": schema.StringAttribute{
+										Computed: true,
+									},
+									"points": schema.Float64Attribute{
+										Computed: true,
+									},
+									"penalty": schema.Float64Attribute{
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupScoreboardDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = providerData.Client
+}
+
+func (d *GroupScoreboardDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupScoreboardDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scoreboard, err := d.client.GroupScoreboardDetails(ctx, &apiclient.GroupScoreboardDetailsRequest{
+		GroupAlias:      data.GroupAlias.ValueString(),
+		ScoreboardAlias: data.Alias.ValueString(),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Group Scoreboard",
+			fmt.Sprintf("An unexpected error occurred while attempting to read the scoreboard %q of the group %q.\n\n", data.Alias.ValueString(), data.GroupAlias.ValueString())+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model.
+	data.Name = types.StringValue(scoreboard.Scoreboard.Name)
+	data.Description = types.StringValue(scoreboard.Scoreboard.Description)
+	data.CreateTime = unixTimeValue(scoreboard.Scoreboard.CreateTime)
+
+	data.Contests = []GroupScoreboardDataSourceContest{}
+	for _, contest := range scoreboard.Contests {
+		data.Contests = append(data.Contests, GroupScoreboardDataSourceContest{
+			Alias:  types.StringValue(contest.Alias),
+			Title:  types.StringValue(contest.Title),
+			Weight: types.Float64Value(contest.Weight),
+			OnlyAC: types.BoolValue(contest.OnlyAC),
+		})
+	}
+
+	data.Ranking = []GroupScoreboardDataSourceRank{}
+	for _, entry := range scoreboard.Ranking {
+		// Users without submissions in a contest have no score for it.
+		scores := []GroupScoreboardDataSourceScore{}
+		for _, contest := range scoreboard.Contests {
+			score := entry.Contests[contest.Alias]
+			scores = append(scores, GroupScoreboardDataSourceScore{
+				Alias:   types.StringValue(contest.Alias),
+				Points:  types.Float64Value(score.Points),
+				Penalty: types.Float64Value(score.Penalty),
+			})
+		}
+		data.Ranking = append(data.Ranking, GroupScoreboardDataSourceRank{
+			Username:     types.StringValue(entry.Username),
+			Name:         types.StringValue(entry.Name),
+			TotalPoints:  types.Float64Value(entry.Total.Points),
+			TotalPenalty: types.Float64Value(entry.Total.Penalty),
+			Contests:     scores,
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
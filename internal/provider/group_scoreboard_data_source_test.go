@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupScoreboardDataSource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupScoreboardDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.omegaup_group_scoreboard.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("League"),
+					),
+					statecheck.ExpectKnownValue(
+						"data.omegaup_group_scoreboard.test",
+						tfjsonpath.New("contests"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"alias":   knownvalue.StringExact("round-1"),
+								"title":   knownvalue.StringExact("round-1"),
+								"weight":  knownvalue.Float64Exact(2),
+								"only_ac": knownvalue.Bool(false),
+							}),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"data.omegaup_group_scoreboard.test",
+						tfjsonpath.New("ranking"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"username":      knownvalue.StringExact("test"),
+								"name":          knownvalue.StringExact("test"),
+								"total_points":  knownvalue.Float64Exact(0),
+								"total_penalty": knownvalue.Float64Exact(0),
+								"contests": knownvalue.ListExact([]knownvalue.Check{
+									knownvalue.ObjectExact(map[string]knownvalue.Check{
+										"alias":   knownvalue.StringExact("round-1"),
+										"points":  knownvalue.Float64Exact(0),
+										"penalty": knownvalue.Float64Exact(0),
+									}),
+								}),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccGroupScoreboardDataSourceConfig = `
+resource "omegaup_group" "group" {
+  alias       = "admins"
+  description = "description"
+}
+resource "omegaup_group_member" "member" {
+  group_alias = omegaup_group.group.alias
+  username    = "test"
+}
+resource "omegaup_group_scoreboard" "league" {
+  group_alias = omegaup_group_member.member.group_alias
+  alias       = "league"
+  name        = "League"
+  description = "Monthly training league"
+
+  contests {
+    alias  = "round-1"
+    weight = 2
+  }
+}
+data "omegaup_group_scoreboard" "test" {
+  group_alias = omegaup_group_scoreboard.league.group_alias
+  alias       = omegaup_group_scoreboard.league.alias
+}
+`
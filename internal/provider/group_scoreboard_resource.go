@@ -0,0 +1,409 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GroupScoreboardResource{}
+var _ resource.ResourceWithImportState = &GroupScoreboardResource{}
+var _ resource.ResourceWithModifyPlan = &GroupScoreboardResource{}
+
+func NewGroupScoreboardResource() resource.Resource {
+	return &GroupScoreboardResource{}
+}
+
+// GroupScoreboardResource defines the resource implementation.
+type GroupScoreboardResource struct {
+	client *apiclient.Client
+}
+
+// GroupScoreboardResourceModel describes the resource data model.
+type GroupScoreboardResourceModel struct {
+	GroupAlias  types.String                  `tfsdk:"group_alias"`
+	Alias       types.String                  `tfsdk:"alias"`
+	Name        types.String                  `tfsdk:"name"`
+	Description types.String                  `tfsdk:"description"`
+	Contests    []GroupScoreboardContestModel `tfsdk:"contests"`
+}
+
+type GroupScoreboardContestModel struct {
+	Alias  types.String  `tfsdk:"alias"`
+	Weight types.Float64 `tfsdk:"weight"`
+	OnlyAC types.Bool    `tfsdk:"only_ac"`
+}
+
+func (r *GroupScoreboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_scoreboard"
+}
+
+func (r *GroupScoreboardResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates a scoreboard aggregating the results of several contests for the members of a group. " +
+			"OmegaUp does not support deleting scoreboards, so destroying it only removes it from the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"group_alias": schema.StringAttribute{
+				MarkdownDescription: "The alias used to identify the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "Unique short title used to identify the scoreboard within the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Friendly name of the scoreboard. Defaults to the alias. OmegaUp cannot change it after the scoreboard is created.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the scoreboard. OmegaUp cannot change it after the scoreboard is created.",
+				Required:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"contests": schema.ListNestedBlock{
+				MarkdownDescription: "Contests included in the scoreboard.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"alias": schema.StringAttribute{
+							MarkdownDescription: "The alias of the contest.",
+							Required:            true,
+						},
+						"weight": schema.Float64Attribute{
+							MarkdownDescription: "Weight of the contest points in the scoreboard. Defaults to `1`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             float64default.StaticFloat64(1),
+						},
+						"only_ac": schema.BoolAttribute{
+							MarkdownDescription: "Only count fully accepted submissions. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *GroupScoreboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = providerData.Client
+}
+
+// ModifyPlan rejects changes to the name and description. OmegaUp has no way
+// to update a scoreboard, and replacing it is not possible either: it cannot
+// be deleted, so its alias would still be in use.
+func (r *GroupScoreboardResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state GroupScoreboardResourceModel
+	var plan GroupScoreboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A different scoreboard is created when the alias changes.
+	if !plan.GroupAlias.Equal(state.GroupAlias) || !plan.Alias.Equal(state.Alias) {
+		return
+	}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Unable to Update Scoreboard Name",
+			fmt.Sprintf("OmegaUp cannot change the name of the scoreboard %q after it is created. "+
+				"Keep the name as %q, or use a different alias to create a new scoreboard.", state.Alias.ValueString(), state.Name.ValueString()),
+		)
+	}
+	if !plan.Description.IsUnknown() && !plan.Description.Equal(state.Description) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("description"),
+			"Unable to Update Scoreboard Description",
+			fmt.Sprintf("OmegaUp cannot change the description of the scoreboard %q after it is created. "+
+				"Keep the description as %q, or use a different alias to create a new scoreboard.", state.Alias.ValueString(), state.Description.ValueString()),
+		)
+	}
+}
+
+func (r *GroupScoreboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GroupScoreboardResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scoreboard := &apiclient.GroupScoreboardCreateRequest{
+		GroupAlias:  data.GroupAlias.ValueString(),
+		Alias:       data.Alias.ValueString(),
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueString(),
+	}
+
+	if scoreboard.Name == "" {
+		scoreboard.Name = scoreboard.Alias
+	}
+
+	err := r.client.GroupScoreboardCreate(ctx, scoreboard)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.Name = types.StringValue(scoreboard.Name)
+
+	if err := r.reconcileContests(ctx, data, nil); err != nil {
+		// OmegaUp cannot delete the scoreboard, so it must be kept in state.
+		// It is saved without contests, the next refresh reads the ones that
+		// were added and the next apply adds the rest. This is a warning
+		// since an error would taint the scoreboard, and replacing it fails
+		// because its alias is still in use.
+		data.Contests = []GroupScoreboardContestModel{}
+		resp.Diagnostics.AddWarning(
+			"Unable to Add Scoreboard Contests",
+			"The scoreboard was created but an unexpected error occurred while attempting to add its contests. "+
+				"The missing contests will be added by the next apply.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupScoreboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GroupScoreboardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scoreboard, err := r.client.GroupScoreboardDetails(ctx, &apiclient.GroupScoreboardDetailsRequest{
+		GroupAlias:      data.GroupAlias.ValueString(),
+		ScoreboardAlias: data.Alias.ValueString(),
+	})
+
+	if apiclient.IsNotFound(err) {
+		// The scoreboard no longer exists, let Terraform recreate it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.Name = types.StringValue(scoreboard.Scoreboard.Name)
+	data.Description = types.StringValue(scoreboard.Scoreboard.Description)
+	data.Contests = orderScoreboardContests(data.Contests, scoreboard.Contests)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupScoreboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var oldData GroupScoreboardResourceModel
+	var data GroupScoreboardResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &oldData)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the contests can change in place, ModifyPlan rejects changes to
+	// the name and description.
+	err := r.reconcileContests(ctx, data, oldData.Contests)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GroupScoreboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// OmegaUp does not support deleting scoreboards, it will only be
+	// removed from state.
+}
+
+func (r *GroupScoreboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: group_alias,alias. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// The remaining attributes are filled by the subsequent Read.
+	var data GroupScoreboardResourceModel
+	data.GroupAlias = types.StringValue(idParts[0])
+	data.Alias = types.StringValue(idParts[1])
+	data.Name = types.StringNull()
+	data.Description = types.StringNull()
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reconcileContests makes the contests of the scoreboard match the plan.
+// OmegaUp cannot update a contest in a scoreboard, so contests whose weight
+// or only_ac changed are removed and added again.
+func (r *GroupScoreboardResource) reconcileContests(ctx context.Context, data GroupScoreboardResourceModel, oldContests []GroupScoreboardContestModel) error {
+	groupAlias := data.GroupAlias.ValueString()
+	scoreboardAlias := data.Alias.ValueString()
+
+	for _, old := range oldContests {
+		contest, exists := findScoreboardContest(data.Contests, old.Alias.ValueString())
+		if exists && contest.Weight.Equal(old.Weight) && contest.OnlyAC.Equal(old.OnlyAC) {
+			continue
+		}
+		err := r.client.GroupScoreboardRemoveContest(ctx, &apiclient.GroupScoreboardRemoveContestRequest{
+			GroupAlias:      groupAlias,
+			ScoreboardAlias: scoreboardAlias,
+			ContestAlias:    old.Alias.ValueString(),
+		})
+		if err != nil && !apiclient.IsNotFound(err) {
+			return err
+		}
+	}
+
+	for _, contest := range data.Contests {
+		old, exists := findScoreboardContest(oldContests, contest.Alias.ValueString())
+		if exists && contest.Weight.Equal(old.Weight) && contest.OnlyAC.Equal(old.OnlyAC) {
+			continue
+		}
+		err := r.client.GroupScoreboardAddContest(ctx, &apiclient.GroupScoreboardAddContestRequest{
+			GroupAlias:      groupAlias,
+			ScoreboardAlias: scoreboardAlias,
+			ContestAlias:    contest.Alias.ValueString(),
+			Weight:          contest.Weight.ValueFloat64(),
+			OnlyAC:          contest.OnlyAC.ValueBool(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func findScoreboardContest(contests []GroupScoreboardContestModel, alias string) (GroupScoreboardContestModel, bool) {
+	for _, contest := range contests {
+		if contest.Alias.ValueString() == alias {
+			return contest, true
+		}
+	}
+	return GroupScoreboardContestModel{}, false
+}
+
+// orderScoreboardContests converts the contests returned by OmegaUp keeping
+// the order they had in the state, so only real changes show up as drift.
+func orderScoreboardContests(previous []GroupScoreboardContestModel, contests []apiclient.GroupScoreboardContest) []GroupScoreboardContestModel {
+	remaining := make(map[string]apiclient.GroupScoreboardContest, len(contests))
+	for _, contest := range contests {
+		remaining[contest.Alias] = contest
+	}
+
+	result := []GroupScoreboardContestModel{}
+	appendContest := func(contest apiclient.GroupScoreboardContest) {
+		result = append(result, GroupScoreboardContestModel{
+			Alias:  types.StringValue(contest.Alias),
+			Weight: types.Float64Value(contest.Weight),
+			OnlyAC: types.BoolValue(contest.OnlyAC),
+		})
+		delete(remaining, contest.Alias)
+	}
+	for _, contest := range previous {
+		if current, exists := remaining[contest.Alias.ValueString()]; exists {
+			appendContest(current)
+		}
+	}
+	for _, contest := range contests {
+		if _, exists := remaining[contest.Alias]; exists {
+			appendContest(contest)
+		}
+	}
+	return result
+}
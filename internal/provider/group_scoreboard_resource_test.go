@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupScoreboardResource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupScoreboardResourceConfig("Monthly training league", `
+  contests {
+    alias = "round-1"
+  }
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_group_scoreboard.league",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("league"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_group_scoreboard.league",
+						tfjsonpath.New("contests"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"alias":   knownvalue.StringExact("round-1"),
+								"weight":  knownvalue.Float64Exact(1),
+								"only_ac": knownvalue.Bool(false),
+							}),
+						}),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "omegaup_group_scoreboard.league",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "admins,league",
+				ImportStateVerifyIdentifierAttribute: "alias",
+			},
+			// Update and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupScoreboardResourceConfig("Monthly training league", `
+  contests {
+    alias  = "round-1"
+    weight = 2
+  }
+  contests {
+    alias   = "round-2"
+    only_ac = true
+  }
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_group_scoreboard.league",
+						tfjsonpath.New("contests"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"alias":   knownvalue.StringExact("round-1"),
+								"weight":  knownvalue.Float64Exact(2),
+								"only_ac": knownvalue.Bool(false),
+							}),
+							knownvalue.ObjectExact(map[string]knownvalue.Check{
+								"alias":   knownvalue.StringExact("round-2"),
+								"weight":  knownvalue.Float64Exact(1),
+								"only_ac": knownvalue.Bool(true),
+							}),
+						}),
+					),
+				},
+			},
+			// OmegaUp cannot update the description, nor replace the
+			// scoreboard since its alias would still be in use.
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupScoreboardResourceConfig("Weekly training league", `
+  contests {
+    alias  = "round-1"
+    weight = 2
+  }
+  contests {
+    alias   = "round-2"
+    only_ac = true
+  }
+`),
+				ExpectError: regexp.MustCompile("Unable to Update Scoreboard Description"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccGroupScoreboardResourceContestError(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The second contest is rejected as duplicated, but the
+			// scoreboard is still saved in state.
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupScoreboardResourceConfig("Monthly training league", `
+  contests {
+    alias = "round-1"
+  }
+  contests {
+    alias = "round-1"
+  }
+`),
+				ExpectNonEmptyPlan: true,
+				Check:              resource.TestCheckResourceAttr("omegaup_group_scoreboard.league", "alias", "league"),
+			},
+			// The scoreboard is not created again.
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupScoreboardResourceConfig("Monthly training league", `
+  contests {
+    alias = "round-1"
+  }
+`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_group_scoreboard.league",
+						tfjsonpath.New("contests"),
+						knownvalue.ListSizeExact(1),
+					),
+				},
+			},
+		},
+	})
+}
+
+func testAccGroupScoreboardResourceConfig(description string, contests string) string {
+	return fmt.Sprintf(`
+resource "omegaup_group" "group" {
+  alias = "admins"
+  description = "description"
+}
+resource "omegaup_group_scoreboard" "league" {
+  group_alias = omegaup_group.group.alias
+  alias = "league"
+  description = %[1]q
+%[2]s}
+`, description, contests)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &GroupsDataSource{}
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &GroupsDataSource{}
+}
+
+// GroupsDataSource defines the data source implementation.
+type GroupsDataSource struct {
+	client *apiclient.Client
+}
+
+// GroupsDataSourceModel describes the data source data model.
+type GroupsDataSourceModel struct {
+	Query      types.String                 `tfsdk:"query"`
+	AliasRegex types.String                 `tfsdk:"alias_regex"`
+	Groups     []GroupsDataSourceGroupModel `tfsdk:"groups"`
+}
+
+// GroupsDataSourceGroupModel describes each of the listed groups.
+type GroupsDataSourceGroupModel struct {
+	Alias       types.String `tfsdk:"alias"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	CreateTime  types.String `tfsdk:"create_time"`
+}
+
+func (d *GroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *GroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists the groups administered by the configured credentials.",
+
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Search the groups matching this text instead of listing the ones owned by the configured credentials.",
+				Optional:            true,
+			},
+			"alias_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the groups whose alias matches this regular expression.",
+				Optional:            true,
+			},
+			"groups": schema.ListNestedAttribute{
+				MarkdownDescription: "Groups found, sorted by alias.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"alias": schema.StringAttribute{
+							MarkdownDescription: "Unique short title used to identify the group.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Friendly name of the group.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the group.",
+							Computed:            true,
+						},
+						"create_time": schema.StringAttribute{
+							MarkdownDescription: "Time the group was created, in RFC3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *GroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = providerData.Client
+}
+
+func (d *GroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data GroupsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var aliasRegex *regexp.Regexp
+	if !data.AliasRegex.IsNull() {
+		var err error
+		aliasRegex, err = regexp.Compile(data.AliasRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("alias_regex"),
+				"Invalid Regular Expression",
+				"Unable to compile alias_regex: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	groups, err := d.listGroups(ctx, data.Query.ValueString(), aliasRegex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to List Groups",
+			"An unexpected error occurred while attempting to list the groups.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Alias < groups[j].Alias })
+
+	// Convert from the API data model to the Terraform data model.
+	data.Groups = []GroupsDataSourceGroupModel{}
+	for _, group := range groups {
+		data.Groups = append(data.Groups, GroupsDataSourceGroupModel{
+			Alias:       types.StringValue(group.Alias),
+			Name:        types.StringValue(group.Name),
+			Description: types.StringValue(group.Description),
+			CreateTime:  unixTimeValue(group.CreateTime),
+		})
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listGroups returns the groups owned by the credentials, or the ones
+// matching the query if any. Searching only returns the alias and name of
+// each group, so the rest of the details are looked up for the groups that
+// pass the alias filter and that the credentials administer.
+func (d *GroupsDataSource) listGroups(ctx context.Context, query string, aliasRegex *regexp.Regexp) ([]apiclient.GroupDetailsResponseGroup, error) {
+	groups := []apiclient.GroupDetailsResponseGroup{}
+
+	if query == "" {
+		res, err := d.client.GroupMyList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range res.Groups {
+			if aliasRegex == nil || aliasRegex.MatchString(group.Alias) {
+				groups = append(groups, group)
+			}
+		}
+		return groups, nil
+	}
+
+	items, err := d.client.GroupList(ctx, &apiclient.GroupListRequest{
+		Query: query,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if aliasRegex != nil && !aliasRegex.MatchString(item.Value) {
+			continue
+		}
+		details, err := d.client.GroupDetails(ctx, &apiclient.GroupDetailsRequest{
+			GroupAlias: item.Value,
+		})
+		if apiclient.IsForbidden(err) {
+			// Only administrators can see the details, keep what the search returned.
+			groups = append(groups, apiclient.GroupDetailsResponseGroup{
+				Alias: item.Value,
+				Name:  item.Label,
+			})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, details.Group)
+	}
+	return groups, nil
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccGroupsDataSource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccGroupsDataSourceConfig,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.omegaup_groups.test",
+						tfjsonpath.New("groups"),
+						knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"alias":       knownvalue.StringExact("training-a"),
+								"description": knownvalue.StringExact("description"),
+							}),
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								"alias": knownvalue.StringExact("training-b"),
+							}),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
+const testAccGroupsDataSourceConfig = `
+resource "omegaup_group" "group" {
+  for_each    = toset(["training-b", "training-a", "admins"])
+  alias       = each.key
+  description = "description"
+}
+data "omegaup_groups" "test" {
+  alias_regex = "^training-"
+  depends_on  = [omegaup_group.group]
+}
+`
@@ -100,16 +100,16 @@ func (r *IdentitiesResource) Configure(ctx context.Context, req resource.Configu
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*apiclient.Client)
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *apiclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *IdentitiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -122,7 +122,7 @@ func (r *IdentitiesResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	err := r.client.IdentityBulkCreate(&apiclient.IdentityBulkCreateRequest{
+	err := r.client.IdentityBulkCreate(ctx, &apiclient.IdentityBulkCreateRequest{
 		GroupAlias: data.GroupAlias.ValueString(),
 		Identities: getIdentitiesOfResource(data),
 	})
@@ -156,10 +156,16 @@ func (r *IdentitiesResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	group, err := r.client.GroupMembers(&apiclient.GroupMembersRequest{
+	group, err := r.client.GroupMembers(ctx, &apiclient.GroupMembersRequest{
 		GroupAlias: data.GroupAlias.ValueString(),
 	})
 
+	if apiclient.IsNotFound(err) {
+		// The group no longer exists, so neither do the identities memberships.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Refresh Resource",
@@ -175,11 +181,9 @@ func (r *IdentitiesResource) Read(ctx context.Context, req resource.ReadRequest,
 	for _, dataIdentity := range data.Identities {
 		// Look it into the group members
 		found := false
-		for _, identity := range group.Identities {
-			if dataIdentity.Username.ValueString() == identity.Username {
-				dataIdentity.Name = types.StringValue(identity.Name)
-				dataIdentity.CountryId = types.StringValue(identity.CountryId)
-				dataIdentity.StateId = types.StringValue(identity.StateId)
+		for i := range group.Identities {
+			if apiclient.EqualUsername(dataIdentity.Username.ValueString(), group.Identities[i].Username) {
+				refreshIdentity(&dataIdentity, &group.Identities[i])
 				found = true
 			}
 		}
@@ -211,7 +215,7 @@ func (r *IdentitiesResource) Update(ctx context.Context, req resource.UpdateRequ
 	for _, oldIdentity := range oldData.Identities {
 		exists := false
 		for _, identity := range data.Identities {
-			if identity.Username.ValueString() == oldIdentity.Username.ValueString() {
+			if apiclient.EqualUsername(identity.Username.ValueString(), oldIdentity.Username.ValueString()) {
 				exists = true
 			}
 		}
@@ -220,7 +224,7 @@ func (r *IdentitiesResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 	for _, identityToRemove := range toRemove {
-		err := r.client.GroupRemoveUser(&apiclient.GroupRemoveUserRequest{
+		err := r.client.GroupRemoveUser(ctx, &apiclient.GroupRemoveUserRequest{
 			GroupAlias:      data.GroupAlias.ValueString(),
 			UsernameOrEmail: identityToRemove.Username.ValueString(),
 		})
@@ -235,7 +239,7 @@ func (r *IdentitiesResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 	}
 
-	err := r.client.IdentityBulkCreate(&apiclient.IdentityBulkCreateRequest{
+	err := r.client.IdentityBulkCreate(ctx, &apiclient.IdentityBulkCreateRequest{
 		GroupAlias: data.GroupAlias.ValueString(),
 		Identities: getIdentitiesOfResource(data),
 	})
@@ -271,7 +275,7 @@ func (r *IdentitiesResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	for _, identityToRemove := range data.Identities {
-		err := r.client.GroupRemoveUser(&apiclient.GroupRemoveUserRequest{
+		err := r.client.GroupRemoveUser(ctx, &apiclient.GroupRemoveUserRequest{
 			GroupAlias:      data.GroupAlias.ValueString(),
 			UsernameOrEmail: identityToRemove.Username.ValueString(),
 		})
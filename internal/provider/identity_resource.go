@@ -97,16 +97,16 @@ func (r *IdentityResource) Configure(ctx context.Context, req resource.Configure
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*apiclient.Client)
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *apiclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	r.client = client
+	r.client = providerData.Client
 }
 
 func (r *IdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,7 +119,7 @@ func (r *IdentityResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	_, err := r.client.IdentityCreate(&apiclient.IdentityCreateRequest{
+	_, err := r.client.IdentityCreate(ctx, &apiclient.IdentityCreateRequest{
 		GroupAlias: data.GroupAlias.ValueString(),
 		Username:   data.Username.ValueString(),
 		Name:       data.Name.ValueString(),
@@ -133,7 +133,7 @@ func (r *IdentityResource) Create(ctx context.Context, req resource.CreateReques
 	if err != nil {
 		// Hacky: Check if the user is already created trying to do a no op with the password
 		fixed := false
-		if err := r.client.IdentityUpdate(&apiclient.IdentityUpdateRequest{
+		if err := r.client.IdentityUpdate(ctx, &apiclient.IdentityUpdateRequest{
 			GroupAlias:       data.GroupAlias.ValueString(),
 			Username:         data.Username.ValueString(),
 			OriginalUsername: data.Username.ValueString(),
@@ -151,7 +151,7 @@ func (r *IdentityResource) Create(ctx context.Context, req resource.CreateReques
 			)
 		} else {
 			// We might only need to re add it!
-			err := r.client.GroupAddUser(&apiclient.GroupAddUserRequest{
+			err := r.client.GroupAddUser(ctx, &apiclient.GroupAddUserRequest{
 				GroupAlias:      data.GroupAlias.ValueString(),
 				UsernameOrEmail: data.Username.ValueString(),
 			})
@@ -191,10 +191,16 @@ func (r *IdentityResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	group, err := r.client.GroupMembers(&apiclient.GroupMembersRequest{
+	group, err := r.client.GroupMembers(ctx, &apiclient.GroupMembersRequest{
 		GroupAlias: data.GroupAlias.ValueString(),
 	})
 
+	if apiclient.IsNotFound(err) {
+		// The group no longer exists, so neither does the identity membership.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Refresh Resource",
@@ -207,9 +213,9 @@ func (r *IdentityResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 	// Look for the alias within the group
 	var apiData *apiclient.GroupIdentity
-	for _, identity := range group.Identities {
-		if apiclient.EqualUsername(identity.Username, data.Username.ValueString()) {
-			apiData = &identity
+	for i := range group.Identities {
+		if apiclient.EqualUsername(group.Identities[i].Username, data.Username.ValueString()) {
+			apiData = &group.Identities[i]
 		}
 	}
 
@@ -218,11 +224,7 @@ func (r *IdentityResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
-	data.Username = types.StringValue(apiData.Username)
-	data.Gender = types.StringValue(apiData.Gender)
-	data.Name = types.StringValue(apiData.Name)
-	data.CountryId = types.StringValue(apiData.CountryId)
-	data.StateId = types.StringValue(apiData.StateId)
+	refreshIdentity(&data, apiData)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -241,7 +243,7 @@ func (r *IdentityResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	err := r.client.IdentityUpdate(&apiclient.IdentityUpdateRequest{
+	err := r.client.IdentityUpdate(ctx, &apiclient.IdentityUpdateRequest{
 		GroupAlias:       data.GroupAlias.ValueString(),
 		Username:         data.Username.ValueString(),
 		OriginalUsername: oldData.Username.ValueString(),
@@ -265,7 +267,7 @@ func (r *IdentityResource) Update(ctx context.Context, req resource.UpdateReques
 
 	if !oldData.Password.Equal(data.Password) {
 		// Password has changed.
-		err = r.client.IdentityChangePassword(&apiclient.IdentityChangePasswordRequest{
+		err = r.client.IdentityChangePassword(ctx, &apiclient.IdentityChangePasswordRequest{
 			Username:   data.Username.ValueString(),
 			GroupAlias: data.GroupAlias.ValueString(),
 			Password:   data.Password.ValueString(),
@@ -297,7 +299,7 @@ func (r *IdentityResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
-	err := r.client.GroupRemoveUser(&apiclient.GroupRemoveUserRequest{
+	err := r.client.GroupRemoveUser(ctx, &apiclient.GroupRemoveUserRequest{
 		GroupAlias:      data.GroupAlias.ValueString(),
 		UsernameOrEmail: data.Username.ValueString(),
 	})
@@ -334,3 +336,32 @@ func (r *IdentityResource) ImportState(ctx context.Context, req resource.ImportS
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// refreshIdentity updates the model with every attribute returned by OmegaUp.
+// The password cannot be read back, so it is kept.
+func refreshIdentity(data *IdentityResourceModel, identity *apiclient.GroupIdentity) {
+	// Usernames are case insensitive, so the configured casing is kept.
+	if !apiclient.EqualUsername(data.Username.ValueString(), identity.Username) {
+		data.Username = types.StringValue(identity.Username)
+	}
+	data.Gender = types.StringValue(identity.Gender)
+	data.Name = types.StringValue(identity.Name)
+	data.CountryId = types.StringValue(identity.CountryId)
+	data.StateId = types.StringValue(identity.StateId)
+	data.SchoolName = schoolNameValue(data.SchoolName, identity)
+}
+
+// schoolNameValue returns the school of an identity. OmegaUp maps the school
+// name to a school id, so the name it returns may differ in case or spacing
+// from the configured one, and it may return only the id. In both cases the
+// known name is kept.
+func schoolNameValue(current types.String, identity *apiclient.GroupIdentity) types.String {
+	school := strings.TrimSpace(identity.School)
+	if school == "" && identity.SchoolId != 0 {
+		return current
+	}
+	if strings.EqualFold(strings.Join(strings.Fields(current.ValueString()), " "), strings.Join(strings.Fields(school), " ")) {
+		return current
+	}
+	return types.StringValue(school)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"terraform-provider-omegaup/internal/apiclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestRefreshIdentity(t *testing.T) {
+	data := IdentityResourceModel{
+		GroupAlias: types.StringValue("group"),
+		Username:   types.StringValue("group:User"),
+		Name:       types.StringValue("Name"),
+		Gender:     types.StringValue("female"),
+		Password:   types.StringValue("password"),
+		SchoolName: types.StringValue("OFMI"),
+		CountryId:  types.StringValue("MX"),
+		StateId:    types.StringValue("MEX"),
+	}
+	refreshIdentity(&data, &apiclient.GroupIdentity{
+		Username:  "group:user",
+		Name:      "Changed",
+		Gender:    "male",
+		CountryId: "US",
+		StateId:   "CA",
+		SchoolId:  7,
+		School:    "Other school",
+	})
+
+	expected := IdentityResourceModel{
+		GroupAlias: types.StringValue("group"),
+		Username:   types.StringValue("group:User"),
+		Name:       types.StringValue("Changed"),
+		Gender:     types.StringValue("male"),
+		Password:   types.StringValue("password"),
+		SchoolName: types.StringValue("Other school"),
+		CountryId:  types.StringValue("US"),
+		StateId:    types.StringValue("CA"),
+	}
+	if data != expected {
+		t.Errorf("expected %+v, got %+v", expected, data)
+	}
+}
+
+func TestSchoolNameValue(t *testing.T) {
+	tests := []struct {
+		current  string
+		identity apiclient.GroupIdentity
+		expected string
+	}{
+		{"OFMI", apiclient.GroupIdentity{SchoolId: 1, School: "OFMI"}, "OFMI"},
+		{"Escuela  Uno", apiclient.GroupIdentity{SchoolId: 1, School: "escuela uno"}, "Escuela  Uno"},
+		{"OFMI", apiclient.GroupIdentity{SchoolId: 1}, "OFMI"},
+		{"OFMI", apiclient.GroupIdentity{SchoolId: 2, School: "Other"}, "Other"},
+		{"OFMI", apiclient.GroupIdentity{}, ""},
+	}
+	for _, test := range tests {
+		actual := schoolNameValue(types.StringValue(test.current), &test.identity)
+		if actual.ValueString() != test.expected {
+			t.Errorf("schoolNameValue(%q, %+v) = %q, expected %q", test.current, test.identity, actual.ValueString(), test.expected)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Name of the profile used when none is configured.
+const defaultProfileName = "default"
+
+// credentialsProfile holds the settings of a named profile of the
+// credentials file, e.g.
+//
+//	[staging]
+//	base_url  = https://staging.omegaup.com
+//	api_token = ...
+type credentialsProfile struct {
+	BaseURL  string
+	ApiToken string
+	Username string
+	Password string
+}
+
+// defaultCredentialsFile returns the path of the credentials file,
+// ~/.config/omegaup/credentials on every OS.
+func defaultCredentialsFile() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".config", "omegaup", "credentials"), nil
+}
+
+// loadProfile reads a profile from the credentials file. When neither the
+// file nor the profile were explicitly requested, a missing file or profile
+// is not an error and an empty profile is returned.
+func loadProfile(credentialsFile string, name string) (*credentialsProfile, error) {
+	explicit := credentialsFile != "" || name != ""
+	if name == "" {
+		name = defaultProfileName
+	}
+	if credentialsFile == "" {
+		var err error
+		credentialsFile, err = defaultCredentialsFile()
+		if err != nil {
+			if explicit {
+				return nil, err
+			}
+			return &credentialsProfile{}, nil
+		}
+	}
+
+	file, err := os.Open(credentialsFile)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return &credentialsProfile{}, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles, err := parseProfiles(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", credentialsFile, err)
+	}
+	values, ok := profiles[name]
+	if !ok {
+		if !explicit {
+			return &credentialsProfile{}, nil
+		}
+		return nil, fmt.Errorf("profile %q not found in %s", name, credentialsFile)
+	}
+
+	return &credentialsProfile{
+		BaseURL:  values["base_url"],
+		ApiToken: values["api_token"],
+		Username: values["username"],
+		Password: values["password"],
+	}, nil
+}
+
+// parseProfiles parses an INI style file with a section per profile. Blank
+// lines and lines starting with # or ; are ignored.
+func parseProfiles(r io.Reader) (map[string]map[string]string, error) {
+	profiles := make(map[string]map[string]string)
+	var current map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if _, exists := profiles[name]; !exists {
+				profiles[name] = make(map[string]string)
+			}
+			current = profiles[name]
+			continue
+		}
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNumber)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: value outside of a profile section", lineNumber)
+		}
+		current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// profileFromEnv reads the base URL and credentials from the environment.
+func profileFromEnv() *credentialsProfile {
+	return &credentialsProfile{
+		BaseURL:  os.Getenv("OMEGAUP_BASE_URL"),
+		ApiToken: os.Getenv("OMEGAUP_API_TOKEN"),
+		Username: os.Getenv("OMEGAUP_USERNAME"),
+		Password: os.Getenv("OMEGAUP_PASSWORD"),
+	}
+}
+
+func (p *credentialsProfile) isEmpty() bool {
+	return *p == credentialsProfile{}
+}
+
+// resolveProfile chooses where the base URL and credentials come from. They
+// are always taken together from a single source, so credentials meant for
+// one server are never sent to another. A profile that was explicitly
+// selected wins over the environment, otherwise the environment wins over the
+// default profile.
+func resolveProfile(env *credentialsProfile, profile *credentialsProfile, explicitProfile bool) *credentialsProfile {
+	if explicitProfile || env.isEmpty() {
+		return profile
+	}
+	return env
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testCredentialsFile = `
+# Production
+[default]
+api_token = production_token
+
+[staging]
+base_url = https://staging.omegaup.com
+username = admin
+password = p4ssw0rd=
+`
+
+func TestLoadProfile(t *testing.T) {
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(credentialsFile, []byte(testCredentialsFile), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	profile, err := loadProfile(credentialsFile, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.ApiToken != "production_token" || profile.BaseURL != "" {
+		t.Errorf("unexpected default profile: %+v", profile)
+	}
+
+	profile, err = loadProfile(credentialsFile, "staging")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := credentialsProfile{
+		BaseURL:  "https://staging.omegaup.com",
+		Username: "admin",
+		Password: "p4ssw0rd=",
+	}
+	if *profile != expected {
+		t.Errorf("unexpected staging profile: %+v", profile)
+	}
+
+	if _, err := loadProfile(credentialsFile, "missing"); err == nil {
+		t.Error("expected an error for a missing profile")
+	}
+}
+
+func TestLoadProfileWithoutFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	profile, err := loadProfile("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *profile != (credentialsProfile{}) {
+		t.Errorf("expected an empty profile, got: %+v", profile)
+	}
+
+	if _, err := loadProfile("", "staging"); err == nil {
+		t.Error("expected an error for an explicit profile without credentials file")
+	}
+}
+
+func TestLoadProfileFromDefaultFile(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	dir := filepath.Join(home, ".config", "omegaup")
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "credentials"), []byte(testCredentialsFile), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	profile, err := loadProfile("", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if profile.ApiToken != "production_token" {
+		t.Errorf("unexpected default profile: %+v", profile)
+	}
+}
+
+func TestResolveProfile(t *testing.T) {
+	t.Setenv("OMEGAUP_BASE_URL", "")
+	t.Setenv("OMEGAUP_API_TOKEN", "production_token")
+	t.Setenv("OMEGAUP_USERNAME", "")
+	t.Setenv("OMEGAUP_PASSWORD", "")
+	env := profileFromEnv()
+	staging := &credentialsProfile{
+		BaseURL:  "https://staging.omegaup.com",
+		ApiToken: "staging_token",
+	}
+
+	// An explicitly selected profile is used as a whole, the token in the
+	// environment must never be sent to the staging host.
+	if source := resolveProfile(env, staging, true); *source != *staging {
+		t.Errorf("expected the explicit profile, got: %+v", source)
+	}
+
+	// Otherwise the environment wins over the default profile, without
+	// borrowing its base URL.
+	source := resolveProfile(env, staging, false)
+	if source.ApiToken != "production_token" || source.BaseURL != "" {
+		t.Errorf("expected only the environment settings, got: %+v", source)
+	}
+
+	// The default profile is used when the environment sets nothing.
+	if source := resolveProfile(&credentialsProfile{}, staging, false); *source != *staging {
+		t.Errorf("expected the default profile, got: %+v", source)
+	}
+}
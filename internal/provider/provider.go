@@ -5,11 +5,14 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"terraform-provider-omegaup/internal/apiclient"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -36,7 +39,34 @@ type OmegaUpProvider struct {
 type OmegaUpProviderModel struct {
 	BaseURL  types.String `tfsdk:"base_url"`
 	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
 	ApiToken types.String `tfsdk:"api_token"`
+
+	Profile         types.String `tfsdk:"profile"`
+	CredentialsFile types.String `tfsdk:"credentials_file"`
+
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait types.String `tfsdk:"retry_max_wait"`
+
+	RequestsPerSecond   types.Float64 `tfsdk:"requests_per_second"`
+	MaxParallelRequests types.Int64   `tfsdk:"max_parallel_requests"`
+
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	Headers            types.Map    `tfsdk:"headers"`
+
+	ReadOnly types.Bool `tfsdk:"read_only"`
+}
+
+// OmegaUpProviderData is handed to resources and data sources on Configure.
+type OmegaUpProviderData struct {
+	Client *apiclient.Client
+	// Identity is the omegaUp identity that owns the configured credentials.
+	Identity apiclient.SessionIdentity
 }
 
 func (p *OmegaUpProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -48,18 +78,86 @@ func (p *OmegaUpProvider) Schema(ctx context.Context, req provider.SchemaRequest
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Base URL for OmegaUp API.",
+				MarkdownDescription: "Base URL for OmegaUp API. Can also be set with the `OMEGAUP_BASE_URL` environment variable. Defaults to `https://omegaup.com`.",
 				Optional:            true,
 			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "OmegaUp username",
+				MarkdownDescription: "OmegaUp username. Used together with `password` to log in when no `api_token` is set. Can also be set with the `OMEGAUP_USERNAME` environment variable.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "OmegaUp password, used to log in when no `api_token` is set. Can also be set with the `OMEGAUP_PASSWORD` environment variable.",
 				Optional:            true,
+				Sensitive:           true,
 			},
 			"api_token": schema.StringAttribute{
 				MarkdownDescription: "OmegaUp API token",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Name of the profile to read from the credentials file. Can also be set with the `OMEGAUP_PROFILE` environment variable. " +
+					"Defaults to `default`. When a profile is selected, its base URL and credentials are used instead of the `OMEGAUP_BASE_URL`, `OMEGAUP_API_TOKEN`, " +
+					"`OMEGAUP_USERNAME` and `OMEGAUP_PASSWORD` environment variables. Otherwise those environment variables take precedence over the `default` profile. " +
+					"Values set in the configuration always take precedence.",
+				Optional: true,
+			},
+			"credentials_file": schema.StringAttribute{
+				MarkdownDescription: "Path of the credentials file, an INI file with a section per profile holding `base_url`, `api_token`, `username` and `password`. " +
+					"Can also be set with the `OMEGAUP_CREDENTIALS_FILE` environment variable. Defaults to `~/.config/omegaup/credentials`.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for transient API errors. Defaults to 3. Set to 0 to disable retries.",
+				Optional:            true,
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait between retries, as a Go duration (e.g. `1s`). Defaults to `1s`.",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait between retries, as a Go duration (e.g. `30s`). Defaults to `30s`.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum sustained number of requests per second sent to OmegaUp. Defaults to 10. Set to 0 to disable the limit.",
+				Optional:            true,
+			},
+			"max_parallel_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests in flight at the same time. Defaults to 4. Set to 0 to disable the limit.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Time limit for each request, as a Go duration (e.g. `1m`). Defaults to `60s`. Set to `0s` to disable the timeout. " +
+					"File uploads are not cut off while being sent, the limit only applies to waiting for the response.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate authority to trust besides the system ones. Useful for self-hosted instances.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded certificate authority to trust besides the system ones.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip TLS certificate verification. Only meant for development instances.",
+				Optional:            true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy used to reach OmegaUp. Defaults to the `HTTPS_PROXY` and `HTTP_PROXY` environment variables.",
+				Optional:            true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Static headers added to every request.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse every API call that modifies OmegaUp, while still allowing reads. " +
+					"Useful to run `terraform plan` with production credentials without any risk of mutation.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -82,7 +180,8 @@ func (p *OmegaUpProvider) Configure(ctx context.Context, req provider.ConfigureR
 		resp.Diagnostics.AddAttributeError(
 			path.Root("base_url"),
 			"Unknown OmegaUp base url",
-			"The provider cannot create the OmegaUp API client as there is an unknown configuration value for the OmegaUp base url.",
+			"The provider cannot create the OmegaUp API client as there is an unknown configuration value for the OmegaUp base url. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the OMEGAUP_BASE_URL environment variable.",
 		)
 	}
 	if data.ApiToken.IsUnknown() {
@@ -93,15 +192,54 @@ func (p *OmegaUpProvider) Configure(ctx context.Context, req provider.ConfigureR
 				"Either target apply the source of the value first, set the value statically in the configuration, or use the OMEGAUP_API_TOKEN environment variable.",
 		)
 	}
+	if data.Username.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Unknown OmegaUp username",
+			"The provider cannot create the OmegaUp API client as there is an unknown configuration value for the OmegaUp username. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the OMEGAUP_USERNAME environment variable.",
+		)
+	}
+	if data.Password.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Unknown OmegaUp password",
+			"The provider cannot create the OmegaUp API client as there is an unknown configuration value for the OmegaUp password. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the OMEGAUP_PASSWORD environment variable.",
+		)
+	}
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
-	baseURL := ""
-	apiToken := os.Getenv("OMEGAUP_API_TOKEN")
+	profileName := os.Getenv("OMEGAUP_PROFILE")
+	credentialsFile := os.Getenv("OMEGAUP_CREDENTIALS_FILE")
+	if !data.Profile.IsNull() {
+		profileName = data.Profile.ValueString()
+	}
+	if !data.CredentialsFile.IsNull() {
+		credentialsFile = data.CredentialsFile.ValueString()
+	}
+
+	profile, err := loadProfile(credentialsFile, profileName)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Load OmegaUp Profile",
+			"The provider cannot read the OmegaUp credentials profile.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Default values to the selected profile or to environment variables, but
+	// override with Terraform configuration value if set.
+	source := resolveProfile(profileFromEnv(), profile, profileName != "")
+	baseURL := source.BaseURL
+	apiToken := source.ApiToken
+	username := source.Username
+	password := source.Password
 
 	if !data.BaseURL.IsNull() {
 		baseURL = data.BaseURL.ValueString()
@@ -109,15 +247,24 @@ func (p *OmegaUpProvider) Configure(ctx context.Context, req provider.ConfigureR
 	if !data.ApiToken.IsNull() {
 		apiToken = data.ApiToken.ValueString()
 	}
+	if !data.Username.IsNull() {
+		username = data.Username.ValueString()
+	}
+	if !data.Password.IsNull() {
+		password = data.Password.ValueString()
+	}
 
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
-	if apiToken == "" {
+	if apiToken == "" && (username == "" || password == "") {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("user"),
-			"Missing OmegaUp api token",
-			"The provider cannot create the OmegaUp API client as there is a missing or empty value for the OmegaUp api token. "+
-				"Set the host value in the configuration or use the OMEGAUP_API_TOKEN environment variable. "+
+			path.Root("api_token"),
+			"Missing OmegaUp credentials",
+			"The provider cannot create the OmegaUp API client as there is a missing or empty value for the OmegaUp api token, "+
+				"and no username and password were provided either. "+
+				"Set the api_token value in the configuration or use the OMEGAUP_API_TOKEN environment variable, "+
+				"or set both username and password (OMEGAUP_USERNAME and OMEGAUP_PASSWORD), "+
+				"or select a profile of the credentials file holding them. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -128,14 +275,163 @@ func (p *OmegaUpProvider) Configure(ctx context.Context, req provider.ConfigureR
 
 	// Example client configuration for data sources and resources
 	client := apiclient.NewClient(apiToken, baseURL)
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	client.Username = username
+	client.Password = password
+	client.ReadOnly = data.ReadOnly.ValueBool()
+
+	if !data.MaxRetries.IsNull() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid OmegaUp max retries",
+				"The max_retries value must be zero or a positive number.",
+			)
+		}
+		client.Retry.MaxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RetryMinWait.IsNull() {
+		client.Retry.MinWait = parseDuration(data.RetryMinWait, path.Root("retry_min_wait"), &resp.Diagnostics)
+	}
+	if !data.RetryMaxWait.IsNull() {
+		client.Retry.MaxWait = parseDuration(data.RetryMaxWait, path.Root("retry_max_wait"), &resp.Diagnostics)
+	}
+	if client.Retry.MinWait > client.Retry.MaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_max_wait"),
+			"Invalid OmegaUp retry wait",
+			"The retry_max_wait value must be greater than or equal to retry_min_wait.",
+		)
+	}
+
+	rateLimit := apiclient.DefaultRateLimitConfig()
+	if !data.RequestsPerSecond.IsNull() {
+		if data.RequestsPerSecond.ValueFloat64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid OmegaUp requests per second",
+				"The requests_per_second value must be zero or a positive number.",
+			)
+		}
+		rateLimit.RequestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+	if !data.MaxParallelRequests.IsNull() {
+		if data.MaxParallelRequests.ValueInt64() < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_parallel_requests"),
+				"Invalid OmegaUp max parallel requests",
+				"The max_parallel_requests value must be zero or a positive number.",
+			)
+		}
+		rateLimit.MaxParallel = int(data.MaxParallelRequests.ValueInt64())
+	}
+	client.SetRateLimit(rateLimit)
+
+	transport := apiclient.TransportConfig{
+		Timeout:            defaultRequestTimeout,
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           data.ProxyURL.ValueString(),
+	}
+	if !data.RequestTimeout.IsNull() {
+		transport.Timeout = parseDuration(data.RequestTimeout, path.Root("request_timeout"), &resp.Diagnostics)
+	}
+	if !data.CACertPEM.IsNull() {
+		transport.CACertsPEM = append(transport.CACertsPEM, []byte(data.CACertPEM.ValueString()))
+	}
+	if !data.CACertFile.IsNull() {
+		pem, err := os.ReadFile(data.CACertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to read OmegaUp CA certificate",
+				"The provider cannot read the CA certificate file. Error: "+err.Error(),
+			)
+		}
+		transport.CACertsPEM = append(transport.CACertsPEM, pem)
+	}
+	if !data.Headers.IsNull() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &transport.Headers, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpClient, err := apiclient.NewHTTPClient(transport)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create OmegaUp API Client",
+			"An unexpected error occurred while configuring the HTTP transport.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+	client.HttpClient = httpClient
+	client.Timeout = transport.Timeout
+
+	// Validate the credentials once, instead of failing on the first
+	// resource call with an opaque error.
+	session, err := client.SessionCurrentSession(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Validate OmegaUp Credentials",
+			"An unexpected error occurred while validating the OmegaUp credentials. "+
+				"Ensure the base_url is reachable and the credentials are correct.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+	if !session.Session.Valid || session.Session.Identity == nil {
+		resp.Diagnostics.AddError(
+			"Invalid OmegaUp Credentials",
+			"OmegaUp does not recognize the configured credentials. "+
+				"Ensure the api_token is not expired or revoked, or that the username and password are correct.",
+		)
+		return
+	}
+	if username != "" && !apiclient.EqualUsername(username, session.Session.Identity.Username) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("username"),
+			"OmegaUp Username Mismatch",
+			fmt.Sprintf("The configured username %q does not match the owner of the credentials, %q. "+
+				"Resources will be managed as %q.", username, session.Session.Identity.Username, session.Session.Identity.Username),
+		)
+	}
+
+	providerData := &OmegaUpProviderData{
+		Client:   client,
+		Identity: *session.Session.Identity,
+	}
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
+}
+
+// Default time limit for each request.
+const defaultRequestTimeout = 60 * time.Second
+
+// parseDuration parses a Go duration string from the provider configuration,
+// reporting an attribute error if it is malformed or negative.
+func parseDuration(value types.String, attributePath path.Path, diags *diag.Diagnostics) time.Duration {
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid duration",
+			fmt.Sprintf("Expected a non-negative duration such as \"1s\" or \"500ms\". Got: %q", value.ValueString()),
+		)
+		return 0
+	}
+	return duration
 }
 
 func (p *OmegaUpProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewGroupResource,
 		NewGroupMemberResource,
+		NewGroupMembershipResource,
+		NewGroupScoreboardResource,
+		NewTeamsGroupResource,
+		NewTeamMembersResource,
+		NewTeamIdentitiesResource,
 		NewIdentityResource,
 		NewIdentitiesResource,
 	}
@@ -146,7 +442,12 @@ func (p *OmegaUpProvider) EphemeralResources(ctx context.Context) []func() ephem
 }
 
 func (p *OmegaUpProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewGroupMembersDataSource,
+		NewGroupScoreboardDataSource,
+	}
 }
 
 func (p *OmegaUpProvider) Functions(ctx context.Context) []func() function.Function {
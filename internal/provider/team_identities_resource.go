@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamIdentitiesResource{}
+
+func NewTeamIdentitiesResource() resource.Resource {
+	return &TeamIdentitiesResource{}
+}
+
+// TeamIdentitiesResource defines the resource implementation.
+type TeamIdentitiesResource struct {
+	client *apiclient.Client
+}
+
+// TeamIdentitiesResourceModel describes the resource data model.
+type TeamIdentitiesResourceModel struct {
+	TeamsGroupAlias types.String                `tfsdk:"teams_group_alias"`
+	Identities      []TeamIdentityResourceModel `tfsdk:"identities"`
+}
+
+// TeamIdentityResourceModel describes each of the team identities.
+type TeamIdentityResourceModel struct {
+	Username   types.String   `tfsdk:"username"`
+	Name       types.String   `tfsdk:"name"`
+	Gender     types.String   `tfsdk:"gender"`
+	Password   types.String   `tfsdk:"password"`
+	SchoolName types.String   `tfsdk:"school_name"`
+	CountryId  types.String   `tfsdk:"country_id"`
+	StateId    types.String   `tfsdk:"state_id"`
+	Usernames  []types.String `tfsdk:"usernames"`
+}
+
+func getTeamIdentitiesOfResource(identities []TeamIdentityResourceModel) []apiclient.TeamIdentity {
+	teamIdentities := []apiclient.TeamIdentity{}
+	for _, data := range identities {
+		usernames := []string{}
+		for _, username := range data.Usernames {
+			usernames = append(usernames, username.ValueString())
+		}
+		teamIdentities = append(teamIdentities, apiclient.TeamIdentity{
+			Username:   data.Username.ValueString(),
+			Name:       data.Name.ValueString(),
+			Gender:     data.Gender.ValueString(),
+			Password:   data.Password.ValueString(),
+			SchoolName: data.SchoolName.ValueString(),
+			CountryId:  data.CountryId.ValueString(),
+			StateId:    data.StateId.ValueString(),
+			Usernames:  strings.Join(usernames, ","),
+		})
+	}
+	return teamIdentities
+}
+
+func (r *TeamIdentitiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_identities"
+}
+
+func (r *TeamIdentitiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	identitySchema := IdentityResourceSchema()
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates a bulk of team identities associated to a teams group, together with their members. " +
+			"It does not fit well with the team members resource on the same teams.",
+
+		Attributes: map[string]schema.Attribute{
+			"teams_group_alias": schema.StringAttribute{
+				MarkdownDescription: "Teams group identifier to associate the teams.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"identities": schema.ListNestedAttribute{
+				MarkdownDescription: "List of team identities",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"username": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the team within the teams group, in the form teams:group:team.",
+							Required:            true,
+						},
+						"name":   identitySchema.Attributes["name"],
+						"gender": identitySchema.Attributes["gender"],
+						"password": schema.StringAttribute{
+							MarkdownDescription: "Password of the team.",
+							Required:            true,
+							Sensitive:           true,
+						},
+						"school_name": identitySchema.Attributes["school_name"],
+						"country_id":  identitySchema.Attributes["country_id"],
+						"state_id":    identitySchema.Attributes["state_id"],
+						"usernames": schema.SetAttribute{
+							MarkdownDescription: "OmegaUp usernames of every member of the team.",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamIdentitiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = providerData.Client
+}
+
+func (r *TeamIdentitiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamIdentitiesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.IdentityBulkCreateForTeams(ctx, &apiclient.IdentityBulkCreateForTeamsRequest{
+		TeamGroupAlias: data.TeamsGroupAlias.ValueString(),
+		TeamIdentities: getTeamIdentitiesOfResource(data.Identities),
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamIdentitiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamIdentitiesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, err := r.client.TeamsGroupTeams(ctx, &apiclient.TeamsGroupTeamsRequest{
+		TeamGroupAlias: data.TeamsGroupAlias.ValueString(),
+	})
+	var users []apiclient.TeamMember
+	if err == nil {
+		users, err = listTeamsMembers(ctx, r.client, data.TeamsGroupAlias.ValueString())
+	}
+
+	if apiclient.IsNotFound(err) {
+		// The teams group no longer exists, so neither do the teams.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Update values, the teams no longer in the teams group are dropped.
+	identities := []TeamIdentityResourceModel{}
+	for _, dataIdentity := range data.Identities {
+		for _, identity := range teams.Identities {
+			if !apiclient.EqualUsername(dataIdentity.Username.ValueString(), identity.Username) {
+				continue
+			}
+			refreshTeamIdentity(&dataIdentity, &identity)
+			dataIdentity.Usernames = membersToUsernames(membersOfTeam(users, identity.Username), dataIdentity.Usernames)
+			identities = append(identities, dataIdentity)
+			break
+		}
+	}
+	data.Identities = identities
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamIdentitiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var oldData TeamIdentitiesResourceModel
+	var data TeamIdentitiesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &oldData)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.update(ctx, oldData, data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamIdentitiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamIdentitiesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, identityToRemove := range data.Identities {
+		err := r.client.TeamsGroupRemoveTeam(ctx, &apiclient.TeamsGroupRemoveTeamRequest{
+			TeamGroupAlias:  data.TeamsGroupAlias.ValueString(),
+			UsernameOrEmail: identityToRemove.Username.ValueString(),
+		})
+		if err != nil && !apiclient.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// update removes the teams no longer listed, creates the new ones in a single
+// call and updates the attributes, password and members of the rest.
+func (r *TeamIdentitiesResource) update(ctx context.Context, oldData TeamIdentitiesResourceModel, data TeamIdentitiesResourceModel) error {
+	teamsGroupAlias := data.TeamsGroupAlias.ValueString()
+
+	for _, oldIdentity := range oldData.Identities {
+		if _, exists := findTeamIdentity(data.Identities, oldIdentity.Username.ValueString()); exists {
+			continue
+		}
+		err := r.client.TeamsGroupRemoveTeam(ctx, &apiclient.TeamsGroupRemoveTeamRequest{
+			TeamGroupAlias:  teamsGroupAlias,
+			UsernameOrEmail: oldIdentity.Username.ValueString(),
+		})
+		if err != nil && !apiclient.IsNotFound(err) {
+			return err
+		}
+	}
+
+	toCreate := []TeamIdentityResourceModel{}
+	for _, identity := range data.Identities {
+		oldIdentity, exists := findTeamIdentity(oldData.Identities, identity.Username.ValueString())
+		if !exists {
+			toCreate = append(toCreate, identity)
+			continue
+		}
+
+		if !identity.Name.Equal(oldIdentity.Name) ||
+			!identity.Gender.Equal(oldIdentity.Gender) ||
+			!identity.SchoolName.Equal(oldIdentity.SchoolName) ||
+			!identity.CountryId.Equal(oldIdentity.CountryId) ||
+			!identity.StateId.Equal(oldIdentity.StateId) {
+			err := r.client.IdentityUpdateIdentityTeam(ctx, &apiclient.IdentityUpdateIdentityTeamRequest{
+				GroupAlias:       teamsGroupAlias,
+				OriginalUsername: oldIdentity.Username.ValueString(),
+				Username:         identity.Username.ValueString(),
+				Name:             identity.Name.ValueString(),
+				Gender:           identity.Gender.ValueString(),
+				SchoolName:       identity.SchoolName.ValueString(),
+				CountryId:        identity.CountryId.ValueString(),
+				StateId:          identity.StateId.ValueString(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if !identity.Password.Equal(oldIdentity.Password) {
+			err := r.client.IdentityChangePassword(ctx, &apiclient.IdentityChangePasswordRequest{
+				GroupAlias: teamsGroupAlias,
+				Username:   identity.Username.ValueString(),
+				Password:   identity.Password.ValueString(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		members := []string{}
+		for _, username := range oldIdentity.Usernames {
+			members = append(members, username.ValueString())
+		}
+		if err := updateTeamMembers(ctx, r.client, identity.Username.ValueString(), members, identity.Usernames); err != nil {
+			return err
+		}
+	}
+
+	if len(toCreate) == 0 {
+		return nil
+	}
+	return r.client.IdentityBulkCreateForTeams(ctx, &apiclient.IdentityBulkCreateForTeamsRequest{
+		TeamGroupAlias: teamsGroupAlias,
+		TeamIdentities: getTeamIdentitiesOfResource(toCreate),
+	})
+}
+
+// refreshTeamIdentity updates a team with the attributes returned by omegaUp,
+// the same way refreshIdentity does for identities.
+func refreshTeamIdentity(data *TeamIdentityResourceModel, identity *apiclient.GroupIdentity) {
+	refreshed := IdentityResourceModel{
+		Username:   data.Username,
+		Name:       data.Name,
+		Gender:     data.Gender,
+		SchoolName: data.SchoolName,
+		CountryId:  data.CountryId,
+		StateId:    data.StateId,
+	}
+	refreshIdentity(&refreshed, identity)
+	data.Username = refreshed.Username
+	data.Name = refreshed.Name
+	data.Gender = refreshed.Gender
+	data.SchoolName = refreshed.SchoolName
+	data.CountryId = refreshed.CountryId
+	data.StateId = refreshed.StateId
+}
+
+func findTeamIdentity(identities []TeamIdentityResourceModel, username string) (TeamIdentityResourceModel, bool) {
+	for _, identity := range identities {
+		if apiclient.EqualUsername(identity.Username.ValueString(), username) {
+			return identity, true
+		}
+	}
+	return TeamIdentityResourceModel{}, false
+}
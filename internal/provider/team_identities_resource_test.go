@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"terraform-provider-omegaup/internal/apiclient"
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamIdentitiesResource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccTeamIdentitiesResourceConfig("Team 1", "password1", `"alice", "bob"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_team_identities.teams",
+						tfjsonpath.New("identities").AtSliceIndex(0).AtMapKey("name"),
+						knownvalue.StringExact("Team 1"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_team_identities.teams",
+						tfjsonpath.New("identities").AtSliceIndex(0).AtMapKey("usernames"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("alice"),
+							knownvalue.StringExact("bob"),
+						}),
+					),
+				},
+			},
+			// Update and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccTeamIdentitiesResourceConfig("Team One", "password1", `"bob", "carol"`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_team_identities.teams",
+						tfjsonpath.New("identities").AtSliceIndex(0).AtMapKey("name"),
+						knownvalue.StringExact("Team One"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_team_identities.teams",
+						tfjsonpath.New("identities").AtSliceIndex(0).AtMapKey("usernames"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("bob"),
+							knownvalue.StringExact("carol"),
+						}),
+					),
+				},
+			},
+			// Password update testing
+			{
+				Config: provider_config(mockServer.URL) + testAccTeamIdentitiesResourceConfig("Team One", "password2", `"bob", "carol"`),
+				Check:  testAccCheckTeamPassword(mockServer.URL, "teams", "teams:teams:team1", "password2"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamIdentitiesResourceConfig(name string, password string, usernames string) string {
+	return fmt.Sprintf(`
+resource "omegaup_teams_group" "teams" {
+  alias       = "teams"
+  description = "description"
+}
+resource "omegaup_team_identities" "teams" {
+  teams_group_alias = omegaup_teams_group.teams.alias
+  identities = [
+    {
+      username    = "teams:teams:team1"
+      name        = %[1]q
+      gender      = "other"
+      password    = %[2]q
+      school_name = "OFMI"
+      country_id  = "MX"
+      state_id    = "MEX"
+      usernames   = [%[3]s]
+    },
+  ]
+}
+`, name, password, usernames)
+}
+
+// testAccCheckTeamPassword checks the password of a team of the mock server.
+func testAccCheckTeamPassword(url string, teamsGroupAlias string, username string, password string) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		client := apiclient.NewClient("api_key", url)
+		teams, err := client.TeamsGroupTeams(context.Background(), &apiclient.TeamsGroupTeamsRequest{
+			TeamGroupAlias: teamsGroupAlias,
+		})
+		if err != nil {
+			return err
+		}
+		for _, identity := range teams.Identities {
+			if identity.Username == username {
+				if identity.Password != password {
+					return fmt.Errorf("expected password %q for %s, got %q", password, username, identity.Password)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("team %s not found", username)
+	}
+}
+
+func TestRefreshTeamIdentity(t *testing.T) {
+	data := TeamIdentityResourceModel{
+		Username:   types.StringValue("teams:group:Team"),
+		Name:       types.StringValue("Team"),
+		Gender:     types.StringValue("decline"),
+		Password:   types.StringValue("password"),
+		SchoolName: types.StringValue("Escuela  Uno"),
+		CountryId:  types.StringValue("MX"),
+		StateId:    types.StringValue("MEX"),
+		Usernames:  []types.String{types.StringValue("member")},
+	}
+	refreshTeamIdentity(&data, &apiclient.GroupIdentity{
+		Username:  "teams:group:team",
+		Name:      "Changed",
+		Gender:    "other",
+		CountryId: "US",
+		StateId:   "CA",
+		SchoolId:  1,
+		School:    "escuela uno",
+	})
+
+	expected := TeamIdentityResourceModel{
+		Username:   types.StringValue("teams:group:Team"),
+		Name:       types.StringValue("Changed"),
+		Gender:     types.StringValue("other"),
+		Password:   types.StringValue("password"),
+		SchoolName: types.StringValue("Escuela  Uno"),
+		CountryId:  types.StringValue("US"),
+		StateId:    types.StringValue("CA"),
+		Usernames:  []types.String{types.StringValue("member")},
+	}
+	if !reflect.DeepEqual(data, expected) {
+		t.Errorf("expected %+v, got %+v", expected, data)
+	}
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Number of members requested on each page of teamsMembers.
+const teamsMembersPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamMembersResource{}
+var _ resource.ResourceWithImportState = &TeamMembersResource{}
+
+func NewTeamMembersResource() resource.Resource {
+	return &TeamMembersResource{}
+}
+
+// TeamMembersResource defines the resource implementation.
+type TeamMembersResource struct {
+	client *apiclient.Client
+}
+
+// TeamMembersResourceModel describes the resource data model.
+type TeamMembersResourceModel struct {
+	TeamsGroupAlias types.String   `tfsdk:"teams_group_alias"`
+	TeamUsername    types.String   `tfsdk:"team_username"`
+	Usernames       []types.String `tfsdk:"usernames"`
+}
+
+func (r *TeamMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Authoritatively manages the user accounts that belong to a team: members not listed are removed.",
+
+		Attributes: map[string]schema.Attribute{
+			"teams_group_alias": schema.StringAttribute{
+				MarkdownDescription: "The alias of the teams group the team belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_username": schema.StringAttribute{
+				MarkdownDescription: "Username of the team identity.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"usernames": schema.SetAttribute{
+				MarkdownDescription: "OmegaUp usernames of every member of the team.",
+				ElementType:         types.StringType,
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *TeamMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = providerData.Client
+}
+
+func (r *TeamMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members, err := teamMembers(ctx, r.client, data.TeamsGroupAlias.ValueString(), data.TeamUsername.ValueString())
+
+	if apiclient.IsNotFound(err) {
+		// The teams group no longer exists, so neither does the team.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	data.Usernames = membersToUsernames(members, data.Usernames)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamMembersResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.reconcile(ctx, data); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMembersResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, username := range data.Usernames {
+		err := r.client.TeamsGroupRemoveMember(ctx, &apiclient.TeamsGroupRemoveMemberRequest{
+			TeamGroupAlias: data.TeamUsername.ValueString(),
+			Username:       username.ValueString(),
+		})
+		if err != nil && !apiclient.IsNotFound(err) {
+			resp.Diagnostics.AddError(
+				"Unable to Delete Resource",
+				"An unexpected error occurred while attempting to delete the resource. "+
+					"Please retry the operation or report this issue to the provider developers.\n\n"+
+					"Error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func (r *TeamMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: teams_group_alias,team_username. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Usernames are filled by the subsequent Read.
+	var data TeamMembersResourceModel
+	data.TeamsGroupAlias = types.StringValue(idParts[0])
+	data.TeamUsername = types.StringValue(idParts[1])
+	data.Usernames = []types.String{}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// reconcile adds the missing usernames to the team and removes the members
+// that are not listed.
+func (r *TeamMembersResource) reconcile(ctx context.Context, data TeamMembersResourceModel) error {
+	teamUsername := data.TeamUsername.ValueString()
+	members, err := teamMembers(ctx, r.client, data.TeamsGroupAlias.ValueString(), teamUsername)
+	if err != nil {
+		return err
+	}
+	return updateTeamMembers(ctx, r.client, teamUsername, members, data.Usernames)
+}
+
+// updateTeamMembers turns the current members of a team into the listed
+// usernames.
+func updateTeamMembers(ctx context.Context, client *apiclient.Client, teamUsername string, members []string, usernames []types.String) error {
+	for _, member := range members {
+		if _, exists := findUsername(usernames, member); exists {
+			continue
+		}
+		err := client.TeamsGroupRemoveMember(ctx, &apiclient.TeamsGroupRemoveMemberRequest{
+			TeamGroupAlias: teamUsername,
+			Username:       member,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	missing := []string{}
+	for _, username := range usernames {
+		if !containsUsername(members, username.ValueString()) {
+			missing = append(missing, username.ValueString())
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return client.TeamsGroupAddMembers(ctx, &apiclient.TeamsGroupAddMembersRequest{
+		TeamGroupAlias: teamUsername,
+		Usernames:      missing,
+	})
+}
+
+// membersToUsernames converts the members of a team, keeping the casing of
+// the usernames already known.
+func membersToUsernames(members []string, known []types.String) []types.String {
+	usernames := []types.String{}
+	for _, member := range members {
+		if username, exists := findUsername(known, member); exists {
+			usernames = append(usernames, username)
+		} else {
+			usernames = append(usernames, types.StringValue(member))
+		}
+	}
+	return usernames
+}
+
+// teamMembers returns the usernames of the members of a team.
+func teamMembers(ctx context.Context, client *apiclient.Client, teamsGroupAlias string, teamUsername string) ([]string, error) {
+	users, err := listTeamsMembers(ctx, client, teamsGroupAlias)
+	if err != nil {
+		return nil, err
+	}
+	return membersOfTeam(users, teamUsername), nil
+}
+
+// listTeamsMembers returns the members of every team in a teams group, going
+// through all the pages.
+func listTeamsMembers(ctx context.Context, client *apiclient.Client, teamsGroupAlias string) ([]apiclient.TeamMember, error) {
+	users := []apiclient.TeamMember{}
+	for page := 1; ; page++ {
+		res, err := client.TeamsGroupTeamsMembers(ctx, &apiclient.TeamsGroupTeamsMembersRequest{
+			TeamGroupAlias: teamsGroupAlias,
+			Page:           page,
+			PageSize:       teamsMembersPageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, res.TeamsUsers...)
+		if len(res.TeamsUsers) == 0 || len(users) >= res.TotalRows {
+			return users, nil
+		}
+	}
+}
+
+func membersOfTeam(users []apiclient.TeamMember, teamUsername string) []string {
+	members := []string{}
+	for _, user := range users {
+		if apiclient.EqualUsername(user.TeamAlias, teamUsername) {
+			members = append(members, user.Username)
+		}
+	}
+	return members
+}
+
+func containsUsername(usernames []string, username string) bool {
+	for _, candidate := range usernames {
+		if apiclient.EqualUsername(candidate, username) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamMembersResource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccTeamMembersResourceConfig([]string{"alice", "bob"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_team_members.team1",
+						tfjsonpath.New("usernames"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("alice"),
+							knownvalue.StringExact("bob"),
+						}),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "omegaup_team_members.team1",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "teams,teams:teams:team1",
+				ImportStateVerifyIdentifierAttribute: "team_username",
+			},
+			// Update and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccTeamMembersResourceConfig([]string{"bob", "carol"}),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_team_members.team1",
+						tfjsonpath.New("usernames"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("bob"),
+							knownvalue.StringExact("carol"),
+						}),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamMembersResourceConfig(usernames []string) string {
+	return fmt.Sprintf(`
+resource "omegaup_teams_group" "teams" {
+  alias       = "teams"
+  description = "description"
+}
+resource "omegaup_team_members" "team1" {
+  teams_group_alias = omegaup_teams_group.teams.alias
+  team_username     = "teams:teams:team1"
+  usernames         = ["%s"]
+}
+`, strings.Join(usernames, `", "`))
+}
@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-omegaup/internal/apiclient"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamsGroupResource{}
+var _ resource.ResourceWithImportState = &TeamsGroupResource{}
+
+func NewTeamsGroupResource() resource.Resource {
+	return &TeamsGroupResource{}
+}
+
+// TeamsGroupResource defines the resource implementation.
+type TeamsGroupResource struct {
+	client *apiclient.Client
+}
+
+// TeamsGroupResourceModel describes the resource data model.
+type TeamsGroupResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Alias               types.String `tfsdk:"alias"`
+	Description         types.String `tfsdk:"description"`
+	Name                types.String `tfsdk:"name"`
+	NumberOfContestants types.Int64  `tfsdk:"number_of_contestants"`
+	CreateTime          types.String `tfsdk:"create_time"`
+}
+
+func (r *TeamsGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams_group"
+}
+
+func (r *TeamsGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Creates a teams group, used to register teams in contests. " +
+			"OmegaUp does not support deleting teams groups, so destroying it only removes it from the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the teams group, same as the alias.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"alias": schema.StringAttribute{
+				MarkdownDescription: "Unique short title used to identify the teams group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the teams group.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Friendly name of the teams group. Defaults to the alias",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"number_of_contestants": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of members of each team, between 1 and 10. Defaults to `3`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(3),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10),
+				},
+			},
+			"create_time": schema.StringAttribute{
+				MarkdownDescription: "Time the teams group was created, in RFC3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamsGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+	providerData, ok := req.ProviderData.(*OmegaUpProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *OmegaUpProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = providerData.Client
+}
+
+func (r *TeamsGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamsGroupResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamsGroup := &apiclient.TeamsGroupCreateRequest{
+		Alias:               data.Alias.ValueString(),
+		Description:         data.Description.ValueString(),
+		Name:                data.Name.ValueString(),
+		NumberOfContestants: int(data.NumberOfContestants.ValueInt64()),
+	}
+
+	if teamsGroup.Name == "" {
+		teamsGroup.Name = teamsGroup.Alias
+	}
+
+	err := r.client.TeamsGroupCreate(ctx, teamsGroup)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Resource",
+			"An unexpected error occurred while attempting to create the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = types.StringValue(teamsGroup.Alias)
+	data.Alias = types.StringValue(teamsGroup.Alias)
+	data.Description = types.StringValue(teamsGroup.Description)
+	data.Name = types.StringValue(teamsGroup.Name)
+	data.CreateTime = types.StringNull()
+
+	// Read back the attributes computed by OmegaUp. OmegaUp cannot delete
+	// the teams group, so it is kept in state even if this fails, and
+	// create_time is filled by the next refresh.
+	details, err := r.client.TeamsGroupDetails(ctx, &apiclient.TeamsGroupDetailsRequest{
+		TeamGroupAlias: teamsGroup.Alias,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Back Resource",
+			"The teams group was created but an unexpected error occurred while attempting to read it back, "+
+				"so create_time is unknown until the next refresh.\n\n"+
+				"Error: "+err.Error(),
+		)
+	} else {
+		data.CreateTime = unixTimeValue(details.TeamGroup.CreateTime)
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamsGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamsGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamsGroup, err := r.client.TeamsGroupDetails(ctx, &apiclient.TeamsGroupDetailsRequest{
+		TeamGroupAlias: data.Alias.ValueString(),
+	})
+
+	if apiclient.IsNotFound(err) {
+		// The teams group no longer exists, let Terraform recreate it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values. OmegaUp cannot rename a teams
+	// group, but it may return the alias with a different case, so the
+	// configured alias is kept.
+	data.ID = data.Alias
+	teamsGroupToModel(&teamsGroup.TeamGroup, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamsGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var oldData TeamsGroupResourceModel
+	var data TeamsGroupResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &oldData)...)
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamsGroup := &apiclient.TeamsGroupUpdateRequest{
+		Alias:               data.Alias.ValueString(),
+		Description:         data.Description.ValueString(),
+		Name:                data.Name.ValueString(),
+		NumberOfContestants: int(data.NumberOfContestants.ValueInt64()),
+	}
+
+	if teamsGroup.Alias != oldData.Alias.ValueString() {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"Could not change alias",
+		)
+
+		return
+	}
+
+	if teamsGroup.Name == "" {
+		teamsGroup.Name = teamsGroup.Alias
+	}
+
+	err := r.client.TeamsGroupUpdate(ctx, teamsGroup)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Update Resource",
+			"An unexpected error occurred while attempting to update the resource. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	data.ID = oldData.ID
+	data.Alias = types.StringValue(teamsGroup.Alias)
+	data.Description = types.StringValue(teamsGroup.Description)
+	data.Name = types.StringValue(teamsGroup.Name)
+	data.CreateTime = oldData.CreateTime
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamsGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// OmegaUp does not support deleting teams groups, it will only be
+	// removed from state.
+}
+
+func (r *TeamsGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamsGroup, err := r.client.TeamsGroupDetails(ctx, &apiclient.TeamsGroupDetailsRequest{
+		TeamGroupAlias: req.ID,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Refresh Resource",
+			"An unexpected error occurred while attempting to refresh resource state. "+
+				"Please retry the operation or report this issue to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Convert from the API data model to the Terraform data model
+	// and set any unknown attribute values.
+	var data TeamsGroupResourceModel
+	data.ID = types.StringValue(teamsGroup.TeamGroup.Alias)
+	data.Alias = types.StringValue(teamsGroup.TeamGroup.Alias)
+	data.NumberOfContestants = types.Int64Value(3)
+	teamsGroupToModel(&teamsGroup.TeamGroup, &data)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func teamsGroupToModel(teamsGroup *apiclient.TeamsGroupDetailsResponseTeamGroup, data *TeamsGroupResourceModel) {
+	data.Description = types.StringValue(teamsGroup.Description)
+	data.Name = types.StringValue(teamsGroup.Name)
+	// Older OmegaUp versions do not return the number of contestants, keep
+	// the known value in that case.
+	if teamsGroup.NumberOfContestants != 0 {
+		data.NumberOfContestants = types.Int64Value(int64(teamsGroup.NumberOfContestants))
+	}
+	data.CreateTime = unixTimeValue(teamsGroup.CreateTime)
+}
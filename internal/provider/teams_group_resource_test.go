@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"terraform-provider-omegaup/internal/mocks"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccTeamsGroupResource(t *testing.T) {
+	mockServer := mocks.NewMockServer()
+	defer mockServer.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccTeamsGroupResourceConfig("teams", "description", 3),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_teams_group.test",
+						tfjsonpath.New("alias"),
+						knownvalue.StringExact("teams"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_teams_group.test",
+						tfjsonpath.New("name"),
+						knownvalue.StringExact("teams"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_teams_group.test",
+						tfjsonpath.New("number_of_contestants"),
+						knownvalue.Int64Exact(3),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_teams_group.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact("teams"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_teams_group.test",
+						tfjsonpath.New("create_time"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "omegaup_teams_group.test",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateId:                        "teams",
+				ImportStateVerifyIdentifierAttribute: "alias",
+			},
+			// Update and Read testing
+			{
+				Config: provider_config(mockServer.URL) + testAccTeamsGroupResourceConfig("teams", "description changed", 2),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"omegaup_teams_group.test",
+						tfjsonpath.New("description"),
+						knownvalue.StringExact("description changed"),
+					),
+					statecheck.ExpectKnownValue(
+						"omegaup_teams_group.test",
+						tfjsonpath.New("number_of_contestants"),
+						knownvalue.Int64Exact(2),
+					),
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamsGroupResourceConfig(alias string, description string, numberOfContestants int) string {
+	return fmt.Sprintf(`
+resource "omegaup_teams_group" "test" {
+  alias                 = %[1]q
+  description           = %[2]q
+  number_of_contestants = %[3]d
+}
+`, alias, description, numberOfContestants)
+}